@@ -0,0 +1,94 @@
+// Command tutorial runs any part of the tutorial from one entry point:
+//
+//	go run ./cmd/tutorial datastructures [-topic maps ...]
+//	go run ./cmd/tutorial functions
+//	go run ./cmd/tutorial fmt [-interactive]
+//
+// Arguments after the subcommand are that subcommand's own flags.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"test-package/datastructures"
+	"test-package/fmtdemo"
+	"test-package/functions"
+)
+
+// command is one subcommand; run gets the arguments after its name and
+// returns the exit code
+type command struct {
+	name    string
+	summary string
+	run     func(args []string) int
+}
+
+var commands = []command{
+	{"datastructures", "interactive data structures tutorial (-h for its flags)", datastructures.Main},
+	{"functions", "multiple returns, named results, defer, goroutines", runFunctions},
+	{"fmt", "tour of the fmt package (-interactive to scan stdin)", runFmt},
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage(os.Stderr)
+		os.Exit(2)
+	}
+	name, args := os.Args[1], os.Args[2:]
+	switch name {
+	case "-h", "-help", "--help", "help":
+		usage(os.Stdout)
+		return
+	}
+	for _, c := range commands {
+		if c.name == name {
+			os.Exit(c.run(args))
+		}
+	}
+	fmt.Fprintf(os.Stderr, "unknown subcommand %q\n\n", name)
+	usage(os.Stderr)
+	os.Exit(2)
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "usage: tutorial <subcommand> [flags]")
+	fmt.Fprintln(w, "\nSubcommands:")
+	for _, c := range commands {
+		fmt.Fprintf(w, "  %-16s %s\n", c.name, c.summary)
+	}
+}
+
+func runFunctions(args []string) int {
+	fs := flag.NewFlagSet("functions", flag.ContinueOnError)
+	if code, ok := parse(fs, args); !ok {
+		return code
+	}
+	functions.Run(os.Stdout)
+	return 0
+}
+
+func runFmt(args []string) int {
+	fs := flag.NewFlagSet("fmt", flag.ContinueOnError)
+	interactive := fs.Bool("interactive", false, "read a name, age, and score from stdin in the scanning section")
+	if code, ok := parse(fs, args); !ok {
+		return code
+	}
+	fmtdemo.Run(*interactive)
+	return 0
+}
+
+// parse parses args into fs. When it returns ok=false the caller should
+// exit with code: 0 after -h, 2 for bad flags (fs has printed why).
+func parse(fs *flag.FlagSet, args []string) (code int, ok bool) {
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0, false
+		}
+		return 2, false
+	}
+	return 0, true
+}
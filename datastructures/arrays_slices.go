@@ -1,7 +1,8 @@
-package main
+package datastructures
 
 import (
 	"fmt"
+	"io"
 	"strings"
 )
 
@@ -11,42 +12,90 @@ import (
 // Slices: Dynamic-size, reference types (backed by arrays)
 
 // ArrayBasics demonstrates fundamental array concepts
-func ArrayBasics() {
-	fmt.Println("\n=== ARRAY BASICS ===")
+func ArrayBasics(w io.Writer) {
+	heading(w, "ARRAY BASICS")
 
 	// Arrays have fixed size, part of their type
 	var arr1 [5]int // Array of 5 ints, initialized to [0, 0, 0, 0, 0]
-	fmt.Printf("Empty array: %v\n", arr1)
+	fmt.Fprintf(w, "Empty array: %v\n", arr1)
 
 	// Array literal initialization
 	arr2 := [5]int{1, 2, 3, 4, 5}
-	fmt.Printf("Initialized array: %v\n", arr2)
+	fmt.Fprintf(w, "Initialized array: %v\n", arr2)
 
 	// Let compiler count the size
 	arr3 := [...]int{10, 20, 30}
-	fmt.Printf("Auto-sized array: %v (length: %d)\n", arr3, len(arr3))
+	fmt.Fprintf(w, "Auto-sized array: %v (length: %d)\n", arr3, len(arr3))
 
 	// Accessing elements (zero-indexed)
-	fmt.Printf("First element: %d, Last element: %d\n", arr2[0], arr2[4])
+	fmt.Fprintf(w, "First element: %d, Last element: %d\n", arr2[0], arr2[4])
 
 	// Arrays are VALUE types - copying creates a new array
 	arr4 := arr2
 	arr4[0] = 999
-	fmt.Printf("Original: %v, Copy: %v (independent)\n", arr2, arr4)
+	fmt.Fprintf(w, "Original: %v, Copy: %v (independent)\n", arr2, arr4)
+}
+
+// cellLabels returns a small board keyed by [row, col] arrays
+func cellLabels() map[[2]int]string {
+	return map[[2]int]string{
+		{0, 0}: "start",
+		{1, 2}: "bridge",
+		{2, 1}: "cave",
+	}
+}
+
+// ArrayAsMapKey demonstrates arrays as map keys
+func ArrayAsMapKey(w io.Writer) {
+	heading(w, "ARRAYS AS MAP KEYS")
+
+	// Arrays are comparable (== compares every element), so a [2]int
+	// works as a key: no struct type or "row,col" string needed
+	a, b := [2]int{1, 2}, [2]int{1, 2}
+	fmt.Fprintf(w, "[2]int{1, 2} == [2]int{1, 2}? %v\n", a == b)
+
+	board := cellLabels()
+	board[[2]int{2, 2}] = "exit" // insert
+
+	// Look up with a fresh literal: equal arrays are the same key
+	if label, ok := board[[2]int{1, 2}]; ok {
+		fmt.Fprintf(w, "board[[2]int{1, 2}] = %q\n", label)
+	}
+	_, ok := board[[2]int{2, 0}]
+	fmt.Fprintf(w, "board[[2]int{2, 0}] exists? %v\n", ok)
+
+	// Ranging over the map gives keys in random order; for a board it's
+	// simpler to walk the coordinates and look each one up
+	for row := 0; row < 3; row++ {
+		for col := 0; col < 3; col++ {
+			if label, ok := board[[2]int{row, col}]; ok {
+				fmt.Fprintf(w, "  row %d, col %d -> %s\n", row, col, label)
+			}
+		}
+	}
+
+	// Slices are NOT comparable, so they can't be keys:
+	//
+	//	m := map[[]int]string{} // compile error: invalid map key type []int
+	//
+	// A slice of known length converts to an array (Go 1.20+), which can.
+	// The conversion panics if the slice is shorter than the array.
+	coords := []int{2, 1}
+	fmt.Fprintf(w, "board[[2]int(coords)] with coords %v = %q\n", coords, board[[2]int(coords)])
 }
 
 // SliceBasics demonstrates fundamental slice concepts
-func SliceBasics() {
-	fmt.Println("\n=== SLICE BASICS ===")
+func SliceBasics(w io.Writer) {
+	heading(w, "SLICE BASICS")
 
 	// Slices are dynamic and reference an underlying array
 	var slice1 []int // nil slice (no underlying array yet)
-	fmt.Printf("Nil slice: %v, len=%d, cap=%d, is nil? %v\n",
+	fmt.Fprintf(w, "Nil slice: %v, len=%d, cap=%d, is nil? %v\n",
 		slice1, len(slice1), cap(slice1), slice1 == nil)
 
 	// Slice literal (creates underlying array automatically)
 	slice2 := []int{1, 2, 3, 4, 5}
-	fmt.Printf("Slice literal: %v, len=%d, cap=%d\n",
+	fmt.Fprintf(w, "Slice literal: %v, len=%d, cap=%d\n",
 		slice2, len(slice2), cap(slice2))
 
 	// Using make() - PROPER WAY to create slices
@@ -54,9 +103,9 @@ func SliceBasics() {
 	slice3 := make([]int, 5)     // length 5, capacity 5, initialized to zeros
 	slice4 := make([]int, 3, 10) // length 3, capacity 10
 
-	fmt.Printf("make([]int, 5): %v, len=%d, cap=%d\n",
+	fmt.Fprintf(w, "make([]int, 5): %v, len=%d, cap=%d\n",
 		slice3, len(slice3), cap(slice3))
-	fmt.Printf("make([]int, 3, 10): %v, len=%d, cap=%d\n",
+	fmt.Fprintf(w, "make([]int, 3, 10): %v, len=%d, cap=%d\n",
 		slice4, len(slice4), cap(slice4))
 	slice3 = append(slice3, 100)
 	slice3 = append(slice3, 101)
@@ -64,85 +113,139 @@ func SliceBasics() {
 	slice3 = append(slice3, 103)
 	slice3 = append(slice3, 104)
 	slice3 = append(slice3, 105)
-	fmt.Printf("make([]int, 5) UPDATED: %v, len=%d, cap=%d\n",
+	fmt.Fprintf(w, "make([]int, 5) UPDATED: %v, len=%d, cap=%d\n",
 		slice3, len(slice3), cap(slice3))
 	slice4 = append(slice4, 694)
-	fmt.Printf("make([]int, 3, 10) UPDATED: %v, len=%d, cap=%d\n",
+	fmt.Fprintf(w, "make([]int, 3, 10) UPDATED: %v, len=%d, cap=%d\n",
 		slice4, len(slice4), cap(slice4))
 
 	// Slices are REFERENCE types - they share the underlying array
 	slice5 := slice2
 	slice5[0] = 999
-	fmt.Printf("Original: %v, Reference: %v (shared backing array)\n", slice2, slice5)
+	fmt.Fprintf(w, "Original: %v, Reference: %v (shared backing array)\n", slice2, slice5)
+}
+
+// bigArray is large enough that copying it is measurable
+// (see arrays_slices_test.go)
+type bigArray [1000]int
+
+// setFirstInArray receives a COPY of the whole array
+func setFirstInArray(a bigArray) {
+	a[0] = -1
+}
+
+// setFirstInSlice receives a copy of the slice header only (pointer, len,
+// cap); the elements it points at are the caller's
+func setFirstInSlice(s []int) {
+	s[0] = -1
+}
+
+// demoPassSemantics shows which mutations inside a function the caller sees
+func demoPassSemantics(w io.Writer) {
+	heading(w, "PASSING ARRAYS VS SLICES TO FUNCTIONS")
+
+	var arr bigArray
+	setFirstInArray(arr)
+	fmt.Fprintf(w, "Array [1000]int after setFirstInArray: arr[0]=%d (caller unchanged; 8000 bytes copied)\n", arr[0])
+
+	slice := make([]int, 1000)
+	setFirstInSlice(slice)
+	fmt.Fprintf(w, "Slice []int after setFirstInSlice: slice[0]=%d (caller sees it; 24-byte header copied)\n", slice[0])
+
+	// To let a function change an array, pass a pointer (or a slice of it)
+	setFirstInSlice(arr[:])
+	fmt.Fprintf(w, "Array after setFirstInSlice(arr[:]): arr[0]=%d (arr[:] shares arr's memory)\n", arr[0])
 }
 
 // SliceOperations demonstrates common slice operations
-func SliceOperations() {
-	fmt.Println("\n=== SLICE OPERATIONS ===")
+func SliceOperations(w io.Writer) {
+	heading(w, "SLICE OPERATIONS")
 
 	slice := []int{10, 20, 30, 40, 50}
 
 	// Slicing syntax: slice[low:high] (low inclusive, high exclusive)
-	fmt.Printf("Original: %v\n", slice)
-	fmt.Printf("slice[1:3]: %v (elements at index 1, 2)\n", slice[1:3])
-	fmt.Printf("slice[:3]: %v (from start to index 3)\n", slice[:3])
-	fmt.Printf("slice[2:]: %v (from index 2 to end)\n", slice[2:])
-	fmt.Printf("slice[:]: %v (entire slice)\n", slice[:])
+	fmt.Fprintf(w, "Original: %v\n", slice)
+	fmt.Fprintf(w, "slice[1:3]: %v (elements at index 1, 2)\n", slice[1:3])
+	fmt.Fprintf(w, "slice[:3]: %v (from start to index 3)\n", slice[:3])
+	fmt.Fprintf(w, "slice[2:]: %v (from index 2 to end)\n", slice[2:])
+	fmt.Fprintf(w, "slice[:]: %v (entire slice)\n", slice[:])
 
 	// APPEND - adds elements to a slice
 	slice = append(slice, 60)
-	fmt.Printf("After append(60): %v, len=%d, cap=%d\n",
+	fmt.Fprintf(w, "After append(60): %v, len=%d, cap=%d\n",
 		slice, len(slice), cap(slice))
 
 	// Append multiple elements
 	slice = append(slice, 70, 80, 90)
-	fmt.Printf("After append(70,80,90): %v, len=%d, cap=%d\n",
+	fmt.Fprintf(w, "After append(70,80,90): %v, len=%d, cap=%d\n",
 		slice, len(slice), cap(slice))
 
 	// Append another slice (note the ... operator)
 	more := []int{100, 110}
 	slice = append(slice, more...)
-	fmt.Printf("After append(slice...): %v\n", slice)
+	fmt.Fprintf(w, "After append(slice...): %v\n", slice)
 
 	// COPY - copies elements between slices
 	source := []int{1, 2, 3, 4, 5}
 	dest := make([]int, 3)
 	copied := copy(dest, source) // copies min(len(dest), len(source))
-	fmt.Printf("Copied %d elements: dest=%v\n", copied, dest)
+	fmt.Fprintf(w, "Copied %d elements: dest=%v\n", copied, dest)
 
 	// Delete element at index (no built-in delete for slices)
 	deleteIndex := 2
 	slice = append(slice[:deleteIndex], slice[deleteIndex+1:]...)
-	fmt.Printf("After deleting index %d: %v\n", deleteIndex, slice)
+	fmt.Fprintf(w, "After deleting index %d: %v\n", deleteIndex, slice)
+
+	// The same operations as generic helpers (slice_utils.go)
+	letters := []string{"a", "b", "e"}
+	letters = Insert(letters, 2, "c", "d")
+	fmt.Fprintf(w, "Insert(at 2, \"c\", \"d\"): %v\n", letters)
+	letters = Insert(letters, len(letters), "f") // index == len appends
+	fmt.Fprintf(w, "Insert(at len, \"f\"): %v\n", letters)
+	letters = RemoveOrdered(letters, 0)
+	fmt.Fprintf(w, "RemoveOrdered(0): %v (order kept, O(n))\n", letters)
+	letters = RemoveUnordered(letters, 0)
+	fmt.Fprintf(w, "RemoveUnordered(0): %v (last moved into the gap, O(1))\n", letters)
+
+	// Comparing slices: == only works against nil, so compare element-wise
+	a, b := []int{1, 2, 3}, []int{1, 2, 3}
+	fmt.Fprintf(w, "EqualSlices(%v, %v): %v (a == b doesn't compile)\n", a, b, EqualSlices(a, b))
+	fmt.Fprintf(w, "EqualSlices(%v, %v): %v (different lengths)\n", a, b[:2], EqualSlices(a, b[:2]))
+	var nilSlice []int
+	fmt.Fprintf(w, "EqualSlices(nil, []int{}): %v (both empty; only == nil tells them apart)\n",
+		EqualSlices(nilSlice, []int{}))
+	words1, words2 := []string{"Go", "Rust"}, []string{"go", "RUST"}
+	fmt.Fprintf(w, "EqualSlicesFunc(%q, %q, strings.EqualFold): %v\n",
+		words1, words2, EqualSlicesFunc(words1, words2, strings.EqualFold))
 }
 
 // SliceCapacityAndGrowth demonstrates how slices grow
-func SliceCapacityAndGrowth() {
-	fmt.Println("\n=== SLICE CAPACITY & GROWTH ===")
+func SliceCapacityAndGrowth(w io.Writer) {
+	heading(w, "SLICE CAPACITY & GROWTH")
 
 	// Start with empty slice
 	var slice []int
-	fmt.Printf("Initial: len=%d, cap=%d\n", len(slice), cap(slice))
+	fmt.Fprintf(w, "Initial: len=%d, cap=%d\n", len(slice), cap(slice))
 
 	// Watch how capacity grows as we append
 	for i := 0; i < 10; i++ {
 		slice = append(slice, i)
-		fmt.Printf("After append(%d): len=%d, cap=%d\n", i, len(slice), cap(slice))
+		fmt.Fprintf(w, "After append(%d): len=%d, cap=%d\n", i, len(slice), cap(slice))
 	}
 
 	// Pre-allocating capacity for performance
-	fmt.Println("\nPre-allocated slice:")
+	fmt.Fprintln(w, "\nPre-allocated slice:")
 	optimized := make([]int, 0, 10) // length 0, capacity 10
 	for i := 0; i < 10; i++ {
 		optimized = append(optimized, i)
-		fmt.Printf("After append(%d): len=%d, cap=%d (no reallocation!)\n",
+		fmt.Fprintf(w, "After append(%d): len=%d, cap=%d (no reallocation!)\n",
 			i, len(optimized), cap(optimized))
 	}
 }
 
 // SlicePatternFilter demonstrates filtering pattern
-func SlicePatternFilter() {
-	fmt.Println("\n=== PATTERN: FILTERING ===")
+func SlicePatternFilter(w io.Writer) {
+	heading(w, "PATTERN: FILTERING")
 
 	numbers := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
 
@@ -153,8 +256,8 @@ func SlicePatternFilter() {
 			evens = append(evens, num)
 		}
 	}
-	fmt.Printf("Original: %v\n", numbers)
-	fmt.Printf("Even numbers: %v\n", evens)
+	fmt.Fprintf(w, "Original: %v\n", numbers)
+	fmt.Fprintf(w, "Even numbers: %v\n", evens)
 
 	// Filter in-place (modifies original slice, more efficient)
 	filtered := numbers[:0] // reuse backing array
@@ -163,12 +266,12 @@ func SlicePatternFilter() {
 			filtered = append(filtered, num)
 		}
 	}
-	fmt.Printf("Numbers > 5 (in-place): %v\n", filtered)
+	fmt.Fprintf(w, "Numbers > 5 (in-place): %v\n", filtered)
 }
 
 // SlicePatternMap demonstrates mapping pattern
-func SlicePatternMap() {
-	fmt.Println("\n=== PATTERN: MAPPING ===")
+func SlicePatternMap(w io.Writer) {
+	heading(w, "PATTERN: MAPPING")
 
 	numbers := []int{1, 2, 3, 4, 5}
 
@@ -177,20 +280,20 @@ func SlicePatternMap() {
 	for i, num := range numbers {
 		doubled[i] = num * 2
 	}
-	fmt.Printf("Original: %v\n", numbers)
-	fmt.Printf("Doubled: %v\n", doubled)
+	fmt.Fprintf(w, "Original: %v\n", numbers)
+	fmt.Fprintf(w, "Doubled: %v\n", doubled)
 
 	// Transform to strings
 	strings := make([]string, len(numbers))
 	for i, num := range numbers {
 		strings[i] = fmt.Sprintf("Number-%d", num)
 	}
-	fmt.Printf("As strings: %v\n", strings)
+	fmt.Fprintf(w, "As strings: %v\n", strings)
 }
 
 // SlicePatternReduce demonstrates reduction pattern
-func SlicePatternReduce() {
-	fmt.Println("\n=== PATTERN: REDUCING ===")
+func SlicePatternReduce(w io.Writer) {
+	heading(w, "PATTERN: REDUCING")
 
 	numbers := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
 
@@ -199,8 +302,8 @@ func SlicePatternReduce() {
 	for _, num := range numbers {
 		sum += num
 	}
-	fmt.Printf("Numbers: %v\n", numbers)
-	fmt.Printf("Sum: %d\n", sum)
+	fmt.Fprintf(w, "Numbers: %v\n", numbers)
+	fmt.Fprintf(w, "Sum: %d\n", sum)
 
 	// Find maximum
 	max := numbers[0]
@@ -209,7 +312,7 @@ func SlicePatternReduce() {
 			max = num
 		}
 	}
-	fmt.Printf("Maximum: %d\n", max)
+	fmt.Fprintf(w, "Maximum: %d\n", max)
 
 	// Count elements matching condition
 	count := 0
@@ -218,37 +321,48 @@ func SlicePatternReduce() {
 			count++
 		}
 	}
-	fmt.Printf("Count of numbers > 5: %d\n", count)
+	fmt.Fprintf(w, "Count of numbers > 5: %d\n", count)
 }
 
 // SliceGotchas demonstrates common pitfalls
-func SliceGotchas() {
-	fmt.Println("\n=== COMMON GOTCHAS ===")
+func SliceGotchas(w io.Writer) {
+	heading(w, "COMMON GOTCHAS")
 
 	// Gotcha 1: Appending to a slice after slicing
-	fmt.Println("\nGotcha 1: Shared backing arrays")
+	fmt.Fprintln(w, "\nGotcha 1: Shared backing arrays")
 	original := []int{1, 2, 3, 4, 5}
 	sub := original[0:2] // {1, 2}
-	fmt.Printf("Original: %v, Sub: %v\n", original, sub)
+	fmt.Fprintf(w, "Original: %v, Sub: %v\n", original, sub)
 
 	sub = append(sub, 999) // This modifies original's backing array!
-	fmt.Printf("After append to sub:\n")
-	fmt.Printf("Original: %v (MODIFIED!)\n", original)
-	fmt.Printf("Sub: %v\n", sub)
+	fmt.Fprintf(w, "After append to sub:\n")
+	fmt.Fprintf(w, "Original: %v (MODIFIED!)\n", original)
+	fmt.Fprintf(w, "Sub: %v\n", sub)
 
 	// Solution: Use full slice expression to limit capacity
-	fmt.Println("\nSolution: Limit capacity with [low:high:max]")
+	fmt.Fprintln(w, "\nSolution: Limit capacity with [low:high:max]")
 	original2 := []int{1, 2, 3, 4, 5}
 	sub2 := original2[0:2:2] // length 2, capacity 2
-	fmt.Printf("Original: %v, Sub: %v, cap(sub)=%d\n", original2, sub2, cap(sub2))
+	fmt.Fprintf(w, "Original: %v, Sub: %v, cap(sub)=%d\n", original2, sub2, cap(sub2))
 
 	sub2 = append(sub2, 999) // Forces new array allocation
-	fmt.Printf("After append to sub:\n")
-	fmt.Printf("Original: %v (UNCHANGED)\n", original2)
-	fmt.Printf("Sub: %v\n", sub2)
+	fmt.Fprintf(w, "After append to sub:\n")
+	fmt.Fprintf(w, "Original: %v (UNCHANGED)\n", original2)
+	fmt.Fprintf(w, "Sub: %v\n", sub2)
+
+	// Solution 2: Clone copies into a fresh backing array, so nothing is
+	// shared no matter how much spare capacity the original has
+	fmt.Fprintln(w, "\nSolution 2: Clone (slice_utils.go)")
+	original3 := make([]int, 5, 10) // spare capacity: append won't reallocate
+	copy(original3, []int{1, 2, 3, 4, 5})
+	clone := Clone(original3[0:2])
+	clone = append(clone, 999)
+	clone[0] = -1
+	fmt.Fprintf(w, "Original: %v (UNCHANGED, cap=%d)\n", original3, cap(original3))
+	fmt.Fprintf(w, "Clone: %v, same backing array? %v\n", clone, &clone[0] == &original3[0])
 
 	// Gotcha 2: Range loop with pointer references
-	fmt.Println("\nGotcha 2: Range variable reuse")
+	fmt.Fprintln(w, "\nGotcha 2: Range variable reuse")
 	numbers := []int{1, 2, 3}
 	var pointers []*int
 
@@ -257,11 +371,11 @@ func SliceGotchas() {
 		pointers = append(pointers, &num)
 	}
 
-	fmt.Printf("Values via pointers (WRONG): ")
+	fmt.Fprintf(w, "Values via pointers (WRONG): ")
 	for _, p := range pointers {
-		fmt.Printf("%d ", *p) // All point to same address!
+		fmt.Fprintf(w, "%d ", *p) // All point to same address!
 	}
-	fmt.Println()
+	fmt.Fprintln(w)
 
 	// Solution: Create a new variable
 	var pointers2 []*int
@@ -270,25 +384,76 @@ func SliceGotchas() {
 		pointers2 = append(pointers2, &num)
 	}
 
-	fmt.Printf("Values via pointers (CORRECT): ")
+	fmt.Fprintf(w, "Values via pointers (CORRECT): ")
 	for _, p := range pointers2 {
-		fmt.Printf("%d ", *p)
+		fmt.Fprintf(w, "%d ", *p)
+	}
+	fmt.Fprintln(w)
+
+	// Gotcha 3: Modifying structs through the range variable
+	fmt.Fprintln(w, "\nGotcha 3: Range variable is a copy of the struct")
+	points := []Point{{X: 1, Y: 2}, {X: 3, Y: 4}}
+
+	scaleAllByValue(points, 2)
+	fmt.Fprintf(w, "After p.Scale(2) ranging by value (WRONG): %v (unchanged)\n", points)
+
+	// Solution: index into the slice so the method gets &points[i]
+	scaleAll(points, 2)
+	fmt.Fprintf(w, "After points[i].Scale(2) by index (CORRECT): %v\n", points)
+	fmt.Fprintln(w, "  (or store pointers: []*Point, where the copy is just an address)")
+
+	// Gotcha 4: Sub-slices stay linked only until one of them reallocates.
+	// %p on a slice prints the address of its first element, i.e. which
+	// backing array it currently uses.
+	fmt.Fprintln(w, "\nGotcha 4: Sub-slices diverge once append reallocates")
+	base := make([]int, 3, 4)
+	copy(base, []int{10, 20, 30})
+	a, b := base[:2], base[:3]
+	fmt.Fprintf(w, "Start:           a=%v (cap %d) @%p, b=%v @%p\n", a, cap(a), a, b, b)
+
+	a = append(a, 99) // len 3 <= cap 4: writes base[2], which b can see
+	fmt.Fprintf(w, "a=append(a, 99): a=%v @%p, b=%v @%p (b changed too!)\n", a, a, b, b)
+
+	a = append(a, 40, 50) // len 5 > cap 4: copied to a new, bigger array
+	a[0] = -1
+	fmt.Fprintf(w, "a=append(a, 40, 50); a[0]=-1:\n  a=%v @%p, b=%v @%p (diverged)\n", a, a, b, b)
+	fmt.Fprintln(w, "  (whether an append is shared depends on spare capacity - use Clone or [low:high:max])")
+}
+
+// scaleAllByValue is the Gotcha 3 bug: p is a copy of each element, so
+// Scale (pointer receiver) changes the copy and points stays as it was
+func scaleAllByValue(points []Point, factor int) {
+	for _, p := range points {
+		p.Scale(factor)
+	}
+}
+
+// scaleAll scales every point in place: points[i] is addressable, so
+// Scale gets &points[i]
+func scaleAll(points []Point, factor int) {
+	for i := range points {
+		points[i].Scale(factor)
 	}
-	fmt.Println()
 }
 
 // RunArraysSlices runs all arrays and slices examples
-func RunArraysSlices() {
-	fmt.Println("\n" + strings.Repeat("=", 60))
-	fmt.Println("ARRAYS AND SLICES IN GO")
-	fmt.Println(strings.Repeat("=", 60))
-
-	ArrayBasics()
-	SliceBasics()
-	SliceOperations()
-	SliceCapacityAndGrowth()
-	SlicePatternFilter()
-	SlicePatternMap()
-	SlicePatternReduce()
-	SliceGotchas()
+func RunArraysSlices(w io.Writer) {
+	topicBanner("ARRAYS AND SLICES IN GO", w)
+
+	ArrayBasics(w)
+	ArrayAsMapKey(w)
+	SliceBasics(w)
+	demoPassSemantics(w)
+	SliceOperations(w)
+	SliceCapacityAndGrowth(w)
+	SlicePatternFilter(w)
+	SlicePatternDebouncedSearch(w)
+	SlicePatternMap(w)
+	SlicePatternFilterMap(w)
+	SlicePatternZip(w)
+	SlicePatternReduce(w)
+	SlicePatternShuntingYard(w)
+	SlicePatternObservable(w)
+	SlicePatternSlidingWindow(w)
+	SliceGotchas(w)
 }
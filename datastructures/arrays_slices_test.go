@@ -0,0 +1,123 @@
+package datastructures
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestPassSemantics(t *testing.T) {
+	var arr bigArray
+	arr[0] = 7
+	setFirstInArray(arr)
+	if arr[0] != 7 {
+		t.Errorf("array mutation leaked to the caller: arr[0] = %d, want 7", arr[0])
+	}
+
+	slice := []int{7, 8, 9}
+	setFirstInSlice(slice)
+	if slice[0] != -1 {
+		t.Errorf("slice mutation not visible to the caller: slice[0] = %d, want -1", slice[0])
+	}
+}
+
+// Copy-cost benchmarks for demoPassSemantics: the same [1000]int passed
+// as an array (copies 8000 bytes per call) and as a slice (copies a
+// 24-byte header).
+//
+//	go test -bench=Pass ./datastructures
+//
+// Expect the array version to be tens of times slower; the slice
+// version's cost doesn't depend on the length at all.
+
+//go:noinline
+func sumFirstLastArray(a bigArray) int { return a[0] + a[len(a)-1] }
+
+//go:noinline
+func sumFirstLastSlice(s []int) int { return s[0] + s[len(s)-1] }
+
+var passSink int
+
+func BenchmarkPassArrayByValue(b *testing.B) {
+	var arr bigArray
+	for i := 0; i < b.N; i++ {
+		passSink = sumFirstLastArray(arr)
+	}
+}
+
+func BenchmarkPassSlice(b *testing.B) {
+	var arr bigArray
+	s := arr[:]
+	for i := 0; i < b.N; i++ {
+		passSink = sumFirstLastSlice(s)
+	}
+}
+
+func TestSubSliceDivergence(t *testing.T) {
+	base := make([]int, 3, 4)
+	a, b := base[:2], base[:3]
+
+	// Appending to a stays in base's array, and b sees it, until a's
+	// length passes cap(base); from the 5th element on a has moved.
+	for n := 3; n <= 6; n++ {
+		a = append(a, n*10)
+		shared := &a[0] == &b[0]
+		if want := n <= cap(base); shared != want {
+			t.Fatalf("len(a)=%d: shares b's array = %v, want %v", n, shared, want)
+		}
+		if n == 3 && b[2] != 30 {
+			t.Errorf("append within capacity: b[2] = %d, want 30 (visible through b)", b[2])
+		}
+	}
+
+	a[0] = -1
+	if b[0] == -1 {
+		t.Error("write to a after reallocation reached b")
+	}
+}
+
+func TestArrayMapKeys(t *testing.T) {
+	board := cellLabels()
+	board[[2]int{4, 5}] = "tower"
+
+	if got, ok := board[[2]int{4, 5}]; !ok || got != "tower" {
+		t.Errorf("board[[2]int{4, 5}] = %q, %v; want \"tower\", true", got, ok)
+	}
+
+	// Two separately built but equal arrays are the same key
+	k1, k2 := [2]int{1, 2}, [2]int{}
+	k2[0], k2[1] = 1, 2
+	board[k1] = "first"
+	board[k2] = "second"
+	if got := board[[2]int{1, 2}]; got != "second" {
+		t.Errorf("board[[2]int{1, 2}] = %q, want the overwritten \"second\"", got)
+	}
+	if len(board) != 4 {
+		t.Errorf("len(board) = %d, want 4 (equal keys must not add entries)", len(board))
+	}
+	if _, ok := board[[2]int{2, 1}]; !ok {
+		t.Error("[2]int{2, 1} missing")
+	}
+	if _, ok := board[[2]int{5, 4}]; ok {
+		t.Error("[2]int{5, 4} should not match [2]int{4, 5}")
+	}
+}
+
+func TestScaleAllByIndexMutatesSlice(t *testing.T) {
+	points := []Point{{X: 1, Y: 2}, {X: 3, Y: 4}}
+
+	scaleAllByValue(points, 2)
+	if want := []Point{{X: 1, Y: 2}, {X: 3, Y: 4}}; !slices.Equal(points, want) {
+		t.Errorf("after ranging by value: %v, want %v (only copies scaled)", points, want)
+	}
+
+	scaleAll(points, 2)
+	if want := []Point{{X: 2, Y: 4}, {X: 6, Y: 8}}; !slices.Equal(points, want) {
+		t.Errorf("after ranging by index: %v, want %v", points, want)
+	}
+
+	// A sub-slice shares the backing array, so its points change in the original
+	scaleAll(points[1:], 10)
+	if want := []Point{{X: 2, Y: 4}, {X: 60, Y: 80}}; !slices.Equal(points, want) {
+		t.Errorf("after scaling points[1:]: %v, want %v", points, want)
+	}
+}
@@ -0,0 +1,77 @@
+package datastructures
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// BOX BANNERS
+// ===========
+// banner draws a box of exactly width columns around a centered title:
+//
+//	╔══════════════════════╗
+//	║  GO DATA STRUCTURES  ║
+//	╚══════════════════════╝
+//
+// - Widths are counted in runes, not bytes ("═" is 3 bytes in UTF-8)
+// - Titles may contain \n for several lines; long lines are word-wrapped
+// - Topic banners use the printer's width (color.go), set from -width
+
+// minBannerWidth leaves room for the two borders plus one column of text
+const minBannerWidth = 5
+
+// banner writes title centered in a box exactly width runes wide
+func banner(title string, width int, w io.Writer) {
+	width = max(width, minBannerWidth)
+	inner := width - 2 // columns between the ║ borders
+	p := printerOf(w)
+
+	fmt.Fprintln(w, p.colorize("╔"+strings.Repeat("═", inner)+"╗", ansiCyan))
+	for _, line := range strings.Split(title, "\n") {
+		for _, l := range wrapWords(line, inner-2) { // keep a space either side
+			pad := inner - utf8.RuneCountInString(l)
+			left := pad / 2
+			text := strings.Repeat(" ", left) + l + strings.Repeat(" ", pad-left)
+			fmt.Fprintln(w, p.colorize("║", ansiCyan)+p.colorize(text, ansiBold)+p.colorize("║", ansiCyan))
+		}
+	}
+	fmt.Fprintln(w, p.colorize("╚"+strings.Repeat("═", inner)+"╝", ansiCyan))
+}
+
+// topicBanner writes the banner printed at the start of each Run* topic,
+// with a blank line before it
+func topicBanner(title string, w io.Writer) {
+	fmt.Fprintln(w)
+	banner(title, printerOf(w).width, w)
+}
+
+// wrapWords splits s into lines of at most width runes, breaking at
+// spaces. A single word longer than width is cut into width-sized pieces.
+func wrapWords(s string, width int) []string {
+	width = max(width, 1)
+	var lines []string
+	line := ""
+	for _, word := range strings.Fields(s) {
+		for utf8.RuneCountInString(word) > width {
+			if line != "" {
+				lines = append(lines, line)
+				line = ""
+			}
+			r := []rune(word)
+			lines = append(lines, string(r[:width]))
+			word = string(r[width:])
+		}
+		switch {
+		case line == "":
+			line = word
+		case utf8.RuneCountInString(line)+1+utf8.RuneCountInString(word) <= width:
+			line += " " + word
+		default:
+			lines = append(lines, line)
+			line = word
+		}
+	}
+	return append(lines, line) // a blank title still gets one (empty) row
+}
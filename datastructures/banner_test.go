@@ -0,0 +1,32 @@
+package datastructures
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestBannerLinesHaveEqualWidth(t *testing.T) {
+	titles := []string{
+		"",
+		"MAPS IN GO",
+		"GO DATA STRUCTURES TUTORIAL\nArrays, Slices, Maps, Structs, new() and make()",
+		"A title that is far too long to fit on a single line of a narrow banner",
+		"Supercalifragilisticexpialidocious",
+	}
+	for _, width := range []int{1, 12, 40, 60, 80} {
+		for _, title := range titles {
+			var sb strings.Builder // a plain writer: no escape codes to inflate the rune count
+			banner(title, width, &sb)
+			lines := strings.Split(strings.TrimSuffix(sb.String(), "\n"), "\n")
+
+			want := max(width, minBannerWidth)
+			for i, line := range lines {
+				if got := utf8.RuneCountInString(line); got != want {
+					t.Errorf("banner(%q, %d) line %d = %q: %d runes, want %d",
+						title, width, i, line, got, want)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package datastructures
+
+import (
+	"fmt"
+	"io"
+	"sync"
+)
+
+// BLOCKING QUEUE
+// ==============
+// A buffered channel already is a bounded, goroutine-safe FIFO queue:
+// - Sending blocks when the buffer is full
+// - Receiving blocks when the buffer is empty
+// - select with a default case gives non-blocking variants
+
+// BlockingQueue is a fixed-capacity FIFO queue safe for concurrent use
+type BlockingQueue[T any] struct {
+	ch chan T
+}
+
+// NewBlockingQueue creates a queue that holds at most capacity items
+func NewBlockingQueue[T any](capacity int) *BlockingQueue[T] {
+	return &BlockingQueue[T]{ch: make(chan T, capacity)}
+}
+
+// Put adds v, blocking while the queue is full
+func (q *BlockingQueue[T]) Put(v T) {
+	q.ch <- v
+}
+
+// Take removes the oldest item, blocking while the queue is empty
+func (q *BlockingQueue[T]) Take() T {
+	return <-q.ch
+}
+
+// TryPut adds v if there is room and reports whether it did
+func (q *BlockingQueue[T]) TryPut(v T) bool {
+	select {
+	case q.ch <- v:
+		return true
+	default:
+		return false
+	}
+}
+
+// TryTake removes the oldest item if one is available
+func (q *BlockingQueue[T]) TryTake() (T, bool) {
+	select {
+	case v := <-q.ch:
+		return v, true
+	default:
+		var zero T
+		return zero, false
+	}
+}
+
+// Len returns the number of buffered items
+func (q *BlockingQueue[T]) Len() int {
+	return len(q.ch)
+}
+
+// BlockingQueueExample demonstrates producers and consumers sharing a queue
+func BlockingQueueExample(w io.Writer) {
+	heading(w, "BLOCKING QUEUE (Channels + Generics)")
+
+	q := NewBlockingQueue[string](2)
+	fmt.Fprintf(w, "TryPut(a): %v, TryPut(b): %v, TryPut(c): %v (capacity 2)\n",
+		q.TryPut("a"), q.TryPut("b"), q.TryPut("c"))
+	first, _ := q.TryTake()
+	second, _ := q.TryTake()
+	_, ok := q.TryTake()
+	fmt.Fprintf(w, "TryTake: %q, %q, then ok=%v (empty)\n", first, second, ok)
+
+	// Two producers, one consumer: Put blocks whenever the consumer lags
+	jobs := NewBlockingQueue[int](3)
+	var wg sync.WaitGroup
+	for p := 1; p <= 2; p++ {
+		wg.Add(1)
+		go func(producer int) {
+			defer wg.Done()
+			for i := 1; i <= 3; i++ {
+				jobs.Put(producer*100 + i)
+			}
+		}(p)
+	}
+
+	sum := 0
+	for i := 0; i < 6; i++ {
+		sum += jobs.Take()
+	}
+	wg.Wait()
+	fmt.Fprintf(w, "Consumed 6 jobs from 2 producers, sum=%d (expected %d)\n",
+		sum, 101+102+103+201+202+203)
+}
@@ -0,0 +1,85 @@
+package datastructures
+
+import (
+	"sync"
+	"testing"
+)
+
+// Run with -race:
+//
+//	go test -race -run BlockingQueue ./datastructures
+func TestBlockingQueueConcurrent(t *testing.T) {
+	const producers, consumers, perProducer = 4, 3, 500
+	type item struct{ producer, seq int }
+
+	q := NewBlockingQueue[item](8) // small capacity: Put and Take both block often
+
+	var prodWG sync.WaitGroup
+	for p := range producers {
+		prodWG.Add(1)
+		go func() {
+			defer prodWG.Done()
+			for seq := range perProducer {
+				q.Put(item{p, seq})
+			}
+		}()
+	}
+
+	// Each consumer takes a fixed share, so none of them blocks forever
+	total := producers * perProducer
+	received := make([][]item, consumers)
+	var consWG sync.WaitGroup
+	for c := range consumers {
+		share := total / consumers
+		if c == 0 {
+			share += total % consumers
+		}
+		consWG.Add(1)
+		go func() {
+			defer consWG.Done()
+			for range share {
+				received[c] = append(received[c], q.Take())
+			}
+		}()
+	}
+	prodWG.Wait()
+	consWG.Wait()
+
+	seen := make(map[item]int)
+	for c, items := range received {
+		last := make(map[int]int) // producer -> last seq this consumer saw
+		for _, it := range items {
+			seen[it]++
+			// The queue is FIFO, so any one consumer sees each producer's
+			// items in the order they were put
+			if prev, ok := last[it.producer]; ok && it.seq <= prev {
+				t.Errorf("consumer %d: producer %d item %d after item %d", c, it.producer, it.seq, prev)
+			}
+			last[it.producer] = it.seq
+		}
+	}
+	if len(seen) != total {
+		t.Errorf("received %d distinct items, want %d", len(seen), total)
+	}
+	for it, n := range seen {
+		if n != 1 {
+			t.Errorf("item %+v received %d times, want exactly once", it, n)
+		}
+	}
+	if q.Len() != 0 {
+		t.Errorf("queue has %d items left, want 0", q.Len())
+	}
+}
+
+func TestBlockingQueueTryVariants(t *testing.T) {
+	q := NewBlockingQueue[int](1)
+	if _, ok := q.TryTake(); ok {
+		t.Error("TryTake on empty queue succeeded")
+	}
+	if !q.TryPut(1) || q.TryPut(2) {
+		t.Error("TryPut should succeed once, then fail at capacity 1")
+	}
+	if v, ok := q.TryTake(); !ok || v != 1 {
+		t.Errorf("TryTake = %d, %v; want 1, true", v, ok)
+	}
+}
@@ -0,0 +1,121 @@
+package datastructures
+
+import (
+	"fmt"
+	"io"
+)
+
+// BUILDING A TREE FROM PARENT REFERENCES
+// ======================================
+// Databases and APIs usually store hierarchies flat: each row knows its
+// parent's id. BuildTree assembles those rows into a forest of nodes.
+// - A map from id to node makes each parent lookup O(1)
+// - Items that can't be reached from any root are part of a cycle
+
+// HierarchyNode is one item in a tree built by BuildTree.
+// (Named to avoid clashing with the TreeNode interface; it implements it.)
+type HierarchyNode[T any] struct {
+	Item     T
+	Subtrees []*HierarchyNode[T] // direct children, in input order
+}
+
+// Label implements TreeNode so the result can be passed to PrintTree
+func (n *HierarchyNode[T]) Label() string {
+	return fmt.Sprint(n.Item)
+}
+
+// Children implements TreeNode
+func (n *HierarchyNode[T]) Children() []TreeNode {
+	out := make([]TreeNode, len(n.Subtrees))
+	for i, c := range n.Subtrees {
+		out[i] = c
+	}
+	return out
+}
+
+// BuildTree links items into a forest and returns its roots in input order.
+// parent returns the parent's id and true, or false if the item is a root.
+// Duplicate ids, unknown parents, and cycles are reported as errors.
+func BuildTree[T any, K comparable](items []T, id func(T) K, parent func(T) (K, bool)) ([]*HierarchyNode[T], error) {
+	nodes := make(map[K]*HierarchyNode[T], len(items))
+	for _, item := range items {
+		key := id(item)
+		if _, dup := nodes[key]; dup {
+			return nil, fmt.Errorf("build tree: duplicate id %v", key)
+		}
+		nodes[key] = &HierarchyNode[T]{Item: item}
+	}
+
+	var roots []*HierarchyNode[T]
+	for _, item := range items {
+		n := nodes[id(item)]
+		parentID, hasParent := parent(item)
+		if !hasParent {
+			roots = append(roots, n)
+			continue
+		}
+		p, ok := nodes[parentID]
+		if !ok {
+			return nil, fmt.Errorf("build tree: %v has unknown parent %v", id(item), parentID)
+		}
+		p.Subtrees = append(p.Subtrees, n)
+	}
+
+	// Every item must be reachable from a root; the rest form cycles
+	reached := 0
+	var walk func(n *HierarchyNode[T])
+	walk = func(n *HierarchyNode[T]) {
+		reached++
+		for _, c := range n.Subtrees {
+			walk(c)
+		}
+	}
+	for _, r := range roots {
+		walk(r)
+	}
+	if reached != len(items) {
+		return nil, fmt.Errorf("build tree: cycle detected (%d of %d items unreachable from a root)",
+			len(items)-reached, len(items))
+	}
+
+	return roots, nil
+}
+
+// orgEntry is a flat row as it might come from a database
+type orgEntry struct {
+	ID, ManagerID int // ManagerID 0 means "no manager"
+	Name          string
+}
+
+func (e orgEntry) String() string { return e.Name }
+
+// StructPatternHierarchy demonstrates building a tree from flat records
+func StructPatternHierarchy(w io.Writer) {
+	heading(w, "PATTERN: TREE FROM PARENT REFERENCES")
+
+	rows := []orgEntry{
+		{ID: 1, Name: "CEO"},
+		{ID: 2, ManagerID: 1, Name: "CTO"},
+		{ID: 3, ManagerID: 1, Name: "CFO"},
+		{ID: 4, ManagerID: 2, Name: "Engineer"},
+		{ID: 5, ManagerID: 2, Name: "Designer"},
+		{ID: 6, ManagerID: 3, Name: "Accountant"},
+	}
+	id := func(e orgEntry) int { return e.ID }
+	manager := func(e orgEntry) (int, bool) { return e.ManagerID, e.ManagerID != 0 }
+
+	roots, err := BuildTree(rows, id, manager)
+	if err != nil {
+		fmt.Fprintf(w, "Error: %v\n", err)
+		return
+	}
+	for _, r := range roots {
+		PrintTree(r, w)
+	}
+
+	// A manages B, B manages A: neither reaches a root
+	cyclic := append(rows, orgEntry{ID: 7, ManagerID: 8, Name: "A"}, orgEntry{ID: 8, ManagerID: 7, Name: "B"})
+	if _, err := BuildTree(cyclic, id, manager); err != nil {
+		fmt.Fprintf(w, "Cyclic input: %v\n", err)
+	}
+}
@@ -0,0 +1,76 @@
+package datastructures
+
+import (
+	"strings"
+	"testing"
+)
+
+func orgID(e orgEntry) int { return e.ID }
+
+func orgManager(e orgEntry) (int, bool) { return e.ManagerID, e.ManagerID != 0 }
+
+// names returns the names of a node's children, in order
+func names(nodes []*HierarchyNode[orgEntry]) []string {
+	out := make([]string, len(nodes))
+	for i, n := range nodes {
+		out[i] = n.Item.Name
+	}
+	return out
+}
+
+func TestBuildTreeHierarchy(t *testing.T) {
+	// Children are listed before their parents on purpose: order of
+	// input must not matter for linking
+	rows := []orgEntry{
+		{ID: 4, ManagerID: 2, Name: "Dev"},
+		{ID: 1, Name: "CEO"},
+		{ID: 2, ManagerID: 1, Name: "CTO"},
+		{ID: 3, ManagerID: 1, Name: "CFO"},
+		{ID: 5, ManagerID: 2, Name: "QA"},
+		{ID: 6, Name: "Board"},
+	}
+	roots, err := BuildTree(rows, orgID, orgManager)
+	if err != nil {
+		t.Fatalf("BuildTree: %v", err)
+	}
+
+	if got := strings.Join(names(roots), ","); got != "CEO,Board" {
+		t.Fatalf("roots = %s, want CEO,Board (in input order)", got)
+	}
+	ceo := roots[0]
+	if got := strings.Join(names(ceo.Subtrees), ","); got != "CTO,CFO" {
+		t.Errorf("CEO's reports = %s, want CTO,CFO", got)
+	}
+	cto := ceo.Subtrees[0]
+	if got := strings.Join(names(cto.Subtrees), ","); got != "Dev,QA" {
+		t.Errorf("CTO's reports = %s, want Dev,QA", got)
+	}
+	for _, leaf := range []*HierarchyNode[orgEntry]{ceo.Subtrees[1], cto.Subtrees[0], cto.Subtrees[1], roots[1]} {
+		if len(leaf.Subtrees) != 0 {
+			t.Errorf("%s should have no reports, has %s", leaf.Item.Name, names(leaf.Subtrees))
+		}
+	}
+}
+
+func TestBuildTreeErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		rows []orgEntry
+		want string
+	}{
+		{"cycle", []orgEntry{
+			{ID: 1, Name: "Root"},
+			{ID: 2, ManagerID: 3, Name: "A"},
+			{ID: 3, ManagerID: 2, Name: "B"},
+		}, "cycle detected (2 of 3"},
+		{"self parent", []orgEntry{{ID: 1, ManagerID: 1, Name: "Loop"}}, "cycle detected"},
+		{"unknown parent", []orgEntry{{ID: 1, ManagerID: 9, Name: "Orphan"}}, "unknown parent 9"},
+		{"duplicate id", []orgEntry{{ID: 1, Name: "A"}, {ID: 1, Name: "B"}}, "duplicate id 1"},
+	}
+	for _, tt := range tests {
+		roots, err := BuildTree(tt.rows, orgID, orgManager)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%s: BuildTree = %v, %v; want error containing %q", tt.name, roots, err, tt.want)
+		}
+	}
+}
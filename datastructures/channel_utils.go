@@ -0,0 +1,68 @@
+package datastructures
+
+import (
+	"fmt"
+	"io"
+)
+
+// CHANNEL HELPERS: FIRST N / LAST N
+// =================================
+// Like s[:n] and s[len(s)-n:], but for streams:
+// - First stops reading after n values; the rest stay in the channel
+// - Last must read until close (the end isn't known before that), but
+//   keeps only n values in a fixed-size ring buffer, so memory stays
+//   O(n) however long the stream is
+
+// First receives at most n values from in and returns them. It returns
+// early if in is closed; values after the first n are left unread.
+func First[T any](in <-chan T, n int) []T {
+	if n <= 0 {
+		return nil
+	}
+	out := make([]T, 0, n)
+	for v := range in {
+		out = append(out, v)
+		if len(out) == n {
+			break
+		}
+	}
+	return out
+}
+
+// Last drains in until it is closed and returns its final n values,
+// oldest first
+func Last[T any](in <-chan T, n int) []T {
+	if n <= 0 {
+		for range in {
+		}
+		return nil
+	}
+
+	ring := NewRingBuffer[T](n, true) // keeps only the newest n
+	for v := range in {
+		ring.Push(v)
+	}
+	return ring.Slice()
+}
+
+// ChannelFirstLast demonstrates the channel helpers
+func ChannelFirstLast(w io.Writer) {
+	heading(w, "CHANNELS: FIRST N AND LAST N")
+
+	numbers := func(count int) <-chan int {
+		ch := make(chan int, count)
+		for i := 1; i <= count; i++ {
+			ch <- i
+		}
+		close(ch)
+		return ch
+	}
+
+	ch := numbers(10)
+	fmt.Fprintf(w, "First(1..10, 3) = %v\n", First(ch, 3))
+	fmt.Fprintf(w, "  %d values left in the channel\n", len(ch))
+	fmt.Fprintf(w, "First(1..2, 5)  = %v (channel closed early)\n", First(numbers(2), 5))
+
+	fmt.Fprintf(w, "Last(1..10, 3)  = %v (ring buffer of 3)\n", Last(numbers(10), 3))
+	fmt.Fprintf(w, "Last(1..2, 5)   = %v\n", Last(numbers(2), 5))
+}
@@ -0,0 +1,82 @@
+package datastructures
+
+import (
+	"slices"
+	"testing"
+)
+
+// closedChan returns a closed channel holding 1..count
+func closedChan(count int) chan int {
+	ch := make(chan int, count)
+	for i := 1; i <= count; i++ {
+		ch <- i
+	}
+	close(ch)
+	return ch
+}
+
+func TestFirst(t *testing.T) {
+	tests := []struct {
+		name     string
+		count, n int
+		want     []int
+	}{
+		{"shorter than n", 2, 5, []int{1, 2}},
+		{"exactly n", 3, 3, []int{1, 2, 3}},
+		{"longer than n", 10, 3, []int{1, 2, 3}},
+		{"empty", 0, 3, []int{}},
+		{"n is zero", 5, 0, nil},
+	}
+	for _, tt := range tests {
+		if got := First(closedChan(tt.count), tt.n); !slices.Equal(got, tt.want) {
+			t.Errorf("%s: First(1..%d, %d) = %v, want %v", tt.name, tt.count, tt.n, got, tt.want)
+		}
+	}
+
+	// Values past the first n stay in the channel
+	ch := closedChan(10)
+	First(ch, 3)
+	if v := <-ch; v != 4 {
+		t.Errorf("next value after First(1..10, 3) = %d, want 4", v)
+	}
+
+	// First returns after n values without waiting for the channel to close
+	open := make(chan int)
+	done := make(chan struct{})
+	defer close(done) // stops the producer
+	go func() {
+		for i := 1; ; i++ {
+			select {
+			case open <- i:
+			case <-done:
+				return
+			}
+		}
+	}()
+	if got, want := First(open, 2), []int{1, 2}; !slices.Equal(got, want) {
+		t.Errorf("First(endless, 2) = %v, want %v", got, want)
+	}
+}
+
+func TestLast(t *testing.T) {
+	tests := []struct {
+		name     string
+		count, n int
+		want     []int
+	}{
+		{"shorter than n", 2, 5, []int{1, 2}},
+		{"exactly n", 3, 3, []int{1, 2, 3}},
+		{"longer than n", 10, 3, []int{8, 9, 10}},
+		{"empty", 0, 3, nil},
+		{"n is zero", 5, 0, nil},
+	}
+	for _, tt := range tests {
+		ch := closedChan(tt.count)
+		if got := Last(ch, tt.n); !slices.Equal(got, tt.want) {
+			t.Errorf("%s: Last(1..%d, %d) = %v, want %v", tt.name, tt.count, tt.n, got, tt.want)
+		}
+		if len(ch) != 0 {
+			t.Errorf("%s: Last left %d values unread, want the channel drained", tt.name, len(ch))
+		}
+	}
+}
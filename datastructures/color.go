@@ -0,0 +1,80 @@
+package datastructures
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+// ANSI COLOR OUTPUT
+// =================
+// All coloring goes through colorize, so turning color off is a single
+// switch. The switch lives in a printer: Main wraps the output writer in
+// one built from --color, --time and --width, and topics keep taking a
+// plain io.Writer. The helpers below look the options up from w.
+// - Default: on for a terminal, off when output is piped or redirected
+// - NO_COLOR (https://no-color.org) turns the default off
+// - Any other writer (a test's strings.Builder, io.Discard) gets the
+//   defaults: no color, no timing, defaultWidth columns
+
+// ANSI escape codes used by the tutorial
+const (
+	ansiReset = "\033[0m"
+	ansiBold  = "\033[1m"
+	ansiRed   = "\033[31m"
+	ansiGreen = "\033[32m"
+	ansiCyan  = "\033[36m"
+)
+
+// defaultWidth is the column width of banners and menu rules unless
+// -width says otherwise
+const defaultWidth = 60
+
+// printer is an io.Writer that carries the tutorial's output options
+type printer struct {
+	io.Writer
+	color  bool // wrap headers, marks and banners in ANSI codes
+	timing bool // print how long each topic takes
+	width  int  // columns of banners and separators
+}
+
+// printerOf returns w's options: w itself if Main created it, otherwise
+// a printer with the defaults
+func printerOf(w io.Writer) *printer {
+	if p, ok := w.(*printer); ok {
+		return p
+	}
+	return &printer{Writer: w, width: defaultWidth}
+}
+
+// colorize wraps s in the given ANSI code, or returns s unchanged
+// when color is disabled
+func (p *printer) colorize(s, code string) string {
+	if !p.color || s == "" {
+		return s
+	}
+	return code + s + ansiReset
+}
+
+// defaultColor reports whether color should be on when --color isn't given
+func defaultColor() bool {
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+	info, err := os.Stdout.Stat()
+	if err != nil {
+		return false
+	}
+	// Terminals are character devices; pipes and files are not
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// heading writes a section header line to w, with a blank line before it
+func heading(w io.Writer, title string) {
+	fmt.Fprintln(w, "\n"+printerOf(w).colorize("=== "+title+" ===", ansiBold+ansiCyan))
+}
+
+// okMark and failMark are the ✓ and ❌ marks, colored green and red
+// when w has color on
+func okMark(w io.Writer) string   { return printerOf(w).colorize("✓", ansiGreen) }
+func failMark(w io.Writer) string { return printerOf(w).colorize("❌", ansiRed) }
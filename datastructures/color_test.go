@@ -0,0 +1,61 @@
+package datastructures
+
+import (
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestPrinterColor(t *testing.T) {
+	var sb strings.Builder
+	plain := &sb
+	colored := &printer{Writer: &sb, color: true, width: defaultWidth}
+	noColor := &printer{Writer: &sb, width: defaultWidth}
+
+	tests := []struct {
+		name string
+		w    io.Writer
+		want string
+	}{
+		{"plain writer", plain, "\n=== TITLE ===\n✓ ❌\n"},
+		{"color off", noColor, "\n=== TITLE ===\n✓ ❌\n"},
+		{"color on", colored, "\n" + ansiBold + ansiCyan + "=== TITLE ===" + ansiReset + "\n" +
+			ansiGreen + "✓" + ansiReset + " " + ansiRed + "❌" + ansiReset + "\n"},
+	}
+	for _, tt := range tests {
+		sb.Reset()
+		heading(tt.w, "TITLE")
+		io.WriteString(tt.w, okMark(tt.w)+" "+failMark(tt.w)+"\n")
+		if got := sb.String(); got != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestPrinterTimingAndWidth(t *testing.T) {
+	topic := func(w io.Writer) { io.WriteString(w, "body\n") }
+
+	var sb strings.Builder
+	timed("demo", &sb, topic)
+	if got := sb.String(); got != "body\n" {
+		t.Errorf("timed on a plain writer = %q, want only the topic output", got)
+	}
+
+	sb.Reset()
+	timed("demo", &printer{Writer: &sb, timing: true}, topic)
+	if got := sb.String(); !strings.HasPrefix(got, "body\n\n[demo: ") || !strings.HasSuffix(got, "]\n") {
+		t.Errorf("timed with timing on = %q, want body then [demo: <duration>]", got)
+	}
+
+	for _, tt := range []struct {
+		w    io.Writer
+		want int
+	}{
+		{&strings.Builder{}, defaultWidth},
+		{&printer{Writer: &strings.Builder{}, width: 30}, 30},
+	} {
+		if got := printerOf(tt.w).width; got != tt.want {
+			t.Errorf("printerOf(%T).width = %d, want %d", tt.w, got, tt.want)
+		}
+	}
+}
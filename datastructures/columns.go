@@ -0,0 +1,67 @@
+package datastructures
+
+import (
+	"fmt"
+	"io"
+)
+
+// ROWS vs COLUMNS (AoS ↔ SoA)
+// ===========================
+// Array of Structs (AoS): []Person - each element holds all fields together
+// Struct of Arrays (SoA): one slice per field - all ages sit contiguously
+// - AoS is natural when you handle one record at a time
+// - SoA is faster when you scan one field across many records
+//   (e.g. averaging ages touches only the ages slice)
+
+// ToColumns splits a slice of Persons into one slice per field
+func ToColumns(people []Person) (names []string, ages []int, cities []string) {
+	names = make([]string, len(people))
+	ages = make([]int, len(people))
+	cities = make([]string, len(people))
+	for i, p := range people {
+		names[i], ages[i], cities[i] = p.Name, p.Age, p.City
+	}
+	return names, ages, cities
+}
+
+// FromColumns rebuilds Persons from per-field slices, which must all
+// have the same length
+func FromColumns(names []string, ages []int, cities []string) ([]Person, error) {
+	if len(names) != len(ages) || len(names) != len(cities) {
+		return nil, fmt.Errorf("column length mismatch: names=%d, ages=%d, cities=%d",
+			len(names), len(ages), len(cities))
+	}
+	people := make([]Person, len(names))
+	for i := range names {
+		people[i] = Person{Name: names[i], Age: ages[i], City: cities[i]}
+	}
+	return people, nil
+}
+
+// StructPatternColumnar demonstrates converting rows to columns and back
+func StructPatternColumnar(w io.Writer) {
+	heading(w, "PATTERN: ROWS ↔ COLUMNS (AoS ↔ SoA)")
+
+	people := []Person{
+		{Name: "Alice", Age: 30, City: "NYC"},
+		{Name: "Bob", Age: 25, City: "LA"},
+		{Name: "Charlie", Age: 35, City: "Chicago"},
+	}
+	fmt.Fprintf(w, "Rows (AoS): %v\n", people)
+
+	names, ages, cities := ToColumns(people)
+	fmt.Fprintf(w, "Columns (SoA):\n  names=%v\n  ages=%v\n  cities=%v\n", names, ages, cities)
+
+	// A column scan touches only the data it needs
+	total := 0
+	for _, age := range ages {
+		total += age
+	}
+	fmt.Fprintf(w, "Average age from the ages column: %.1f\n", float64(total)/float64(len(ages)))
+
+	rows, err := FromColumns(names, ages, cities)
+	fmt.Fprintf(w, "Back to rows: %v (err=%v)\n", rows, err)
+
+	_, err = FromColumns(names, ages[:2], cities)
+	fmt.Fprintf(w, "Mismatched columns: %v\n", err)
+}
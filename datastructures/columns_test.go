@@ -0,0 +1,71 @@
+package datastructures
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestColumnsRoundTrip(t *testing.T) {
+	people := []Person{
+		{Name: "Alice", Age: 30, City: "NYC"},
+		{Name: "Bob", Age: 25, City: "LA"},
+		{Name: "Charlie", Age: 35},
+	}
+
+	names, ages, cities := ToColumns(people)
+	if want := []string{"Alice", "Bob", "Charlie"}; !slices.Equal(names, want) {
+		t.Errorf("names = %v, want %v", names, want)
+	}
+	if want := []int{30, 25, 35}; !slices.Equal(ages, want) {
+		t.Errorf("ages = %v, want %v", ages, want)
+	}
+	if want := []string{"NYC", "LA", ""}; !slices.Equal(cities, want) {
+		t.Errorf("cities = %q, want %q", cities, want)
+	}
+
+	got, err := FromColumns(names, ages, cities)
+	if err != nil {
+		t.Fatalf("FromColumns: %v", err)
+	}
+	if !slices.Equal(got, people) {
+		t.Errorf("round trip = %v, want %v", got, people)
+	}
+
+	// No rows means empty columns, and back to no rows
+	names, ages, cities = ToColumns(nil)
+	if got, err := FromColumns(names, ages, cities); err != nil || len(got) != 0 {
+		t.Errorf("FromColumns(ToColumns(nil)) = %v, %v, want empty, nil", got, err)
+	}
+}
+
+func TestFromColumnsLengthMismatch(t *testing.T) {
+	names := []string{"Alice", "Bob"}
+	ages := []int{30, 25}
+	cities := []string{"NYC", "LA"}
+
+	tests := []struct {
+		name   string
+		names  []string
+		ages   []int
+		cities []string
+		want   string
+	}{
+		{"short names", names[:1], ages, cities, "names=1, ages=2, cities=2"},
+		{"short ages", names, ages[:1], cities, "names=2, ages=1, cities=2"},
+		{"short cities", names, ages, nil, "names=2, ages=2, cities=0"},
+	}
+	for _, tt := range tests {
+		people, err := FromColumns(tt.names, tt.ages, tt.cities)
+		if err == nil {
+			t.Errorf("%s: FromColumns = %v, want an error", tt.name, people)
+			continue
+		}
+		if people != nil {
+			t.Errorf("%s: FromColumns returned %v with the error, want nil", tt.name, people)
+		}
+		if !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%s: error %q does not mention %q", tt.name, err, tt.want)
+		}
+	}
+}
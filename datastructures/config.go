@@ -0,0 +1,81 @@
+package datastructures
+
+import "time"
+
+// FUNCTIONAL OPTIONS
+// ==================
+// A constructor with many optional settings either grows a long
+// parameter list or needs a builder. Functional options keep the call
+// site readable and the defaults in one place:
+//
+//	cfg := NewConfig(WithHost("example.com"), WithDebug(true))
+//
+// - Each option is a func that modifies the struct being built
+// - NewConfig applies defaults first, then options in order,
+//   so a later option overrides an earlier one
+// - Build[T] does the same for any struct type, with plain func(*T) options
+
+// Config holds server settings
+type Config struct {
+	Host    string
+	Port    int
+	Timeout time.Duration
+	Debug   bool
+}
+
+// ConfigOption customizes a Config in NewConfig
+type ConfigOption func(*Config)
+
+// WithHost sets the host name
+func WithHost(host string) ConfigOption {
+	return func(c *Config) {
+		c.Host = host
+	}
+}
+
+// WithPort sets the port
+func WithPort(port int) ConfigOption {
+	return func(c *Config) {
+		c.Port = port
+	}
+}
+
+// WithTimeout sets the request timeout
+func WithTimeout(timeout time.Duration) ConfigOption {
+	return func(c *Config) {
+		c.Timeout = timeout
+	}
+}
+
+// WithDebug turns debug mode on or off
+func WithDebug(debug bool) ConfigOption {
+	return func(c *Config) {
+		c.Debug = debug
+	}
+}
+
+// NewConfig returns a Config with defaults, modified by opts in order
+func NewConfig(opts ...ConfigOption) *Config {
+	config := &Config{
+		Host:    "localhost",
+		Port:    8080,
+		Timeout: 30 * time.Second,
+		Debug:   false,
+	}
+
+	for _, opt := range opts {
+		opt(config)
+	}
+
+	return config
+}
+
+// Build is the options pattern for any type: start from defaults (a
+// copy, since T is passed by value) and apply each option in order.
+// NewConfig is the same idea specialized to *Config.
+func Build[T any](defaults T, opts ...func(*T)) T {
+	for _, opt := range opts {
+		opt(&defaults)
+	}
+	return defaults
+}
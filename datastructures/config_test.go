@@ -0,0 +1,86 @@
+package datastructures
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewConfigDefaults(t *testing.T) {
+	want := Config{Host: "localhost", Port: 8080, Timeout: 30 * time.Second, Debug: false}
+	if got := *NewConfig(); got != want {
+		t.Errorf("NewConfig() = %+v, want %+v", got, want)
+	}
+
+	// Options change only their own field
+	got := *NewConfig(WithPort(9000))
+	want.Port = 9000
+	if got != want {
+		t.Errorf("NewConfig(WithPort(9000)) = %+v, want %+v", got, want)
+	}
+}
+
+func TestNewConfigOptionOrder(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []ConfigOption
+		want Config
+	}{
+		{"every option",
+			[]ConfigOption{WithHost("example.com"), WithPort(443), WithTimeout(5 * time.Second), WithDebug(true)},
+			Config{Host: "example.com", Port: 443, Timeout: 5 * time.Second, Debug: true}},
+		{"later timeout wins",
+			[]ConfigOption{WithTimeout(5 * time.Second), WithTimeout(time.Minute)},
+			Config{Host: "localhost", Port: 8080, Timeout: time.Minute}},
+		{"later timeout wins, reversed",
+			[]ConfigOption{WithTimeout(time.Minute), WithTimeout(5 * time.Second)},
+			Config{Host: "localhost", Port: 8080, Timeout: 5 * time.Second}},
+		{"debug switched back off",
+			[]ConfigOption{WithDebug(true), WithHost("a"), WithDebug(false)},
+			Config{Host: "a", Port: 8080, Timeout: 30 * time.Second}},
+	}
+	for _, tt := range tests {
+		if got := *NewConfig(tt.opts...); got != tt.want {
+			t.Errorf("%s: NewConfig = %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+
+	// Each call starts from fresh defaults
+	NewConfig(WithHost("changed"))
+	if got := NewConfig().Host; got != "localhost" {
+		t.Errorf("NewConfig().Host after an earlier WithHost = %q, want %q", got, "localhost")
+	}
+}
+
+func TestBuildTwoTypes(t *testing.T) {
+	// Config, with options written as plain func(*Config)...
+	cfg := Build(Config{Host: "localhost", Port: 8080},
+		func(c *Config) { c.Port = 8443 },
+		func(c *Config) { c.Debug = true })
+	if want := (Config{Host: "localhost", Port: 8443, Debug: true}); cfg != want {
+		t.Errorf("Build[Config] = %+v, want %+v", cfg, want)
+	}
+
+	// ...and the ConfigOption helpers, which have the same underlying type
+	cfg = Build(*NewConfig(), WithHost("example.com"))
+	if cfg.Host != "example.com" || cfg.Port != 8080 {
+		t.Errorf("Build(*NewConfig(), WithHost) = %+v, want example.com:8080", cfg)
+	}
+
+	// The same function builds a Person
+	withCity := func(city string) func(*Person) {
+		return func(p *Person) { p.City = city }
+	}
+	defaults := Person{Name: "Dana", Age: 40}
+	person := Build(defaults, withCity("Paris"), withCity("Berlin"))
+	if want := (Person{Name: "Dana", Age: 40, City: "Berlin"}); person != want {
+		t.Errorf("Build[Person] = %+v, want %+v", plainPerson(person), plainPerson(want))
+	}
+	if defaults.City != "" {
+		t.Errorf("Build modified the defaults passed in: %+v", plainPerson(defaults))
+	}
+
+	// No options: the defaults come back unchanged
+	if got := Build(defaults); got != defaults {
+		t.Errorf("Build(defaults) = %+v, want %+v", plainPerson(got), plainPerson(defaults))
+	}
+}
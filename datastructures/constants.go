@@ -0,0 +1,125 @@
+package datastructures
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// CONSTANTS AND IOTA
+// ==================
+// Go has no enum keyword; a named type plus a const block with iota
+// does the job:
+// - iota is 0 on the first line of a const block and counts up by one
+//   per line; a line without "= expr" repeats the previous expression
+// - A named type (type Weekday int) keeps enums from mixing with plain
+//   ints, and lets them have methods like String()
+// - 1 << iota gives one bit per constant, so flags combine with |
+
+// Weekday is a day of the week, Sunday first (like time.Weekday)
+type Weekday int
+
+const (
+	Sunday Weekday = iota // 0
+	Monday                // 1: repeats "Weekday = iota" with iota = 1
+	Tuesday
+	Wednesday
+	Thursday
+	Friday
+	Saturday
+)
+
+var weekdayNames = [...]string{"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday"}
+
+// String implements fmt.Stringer. Out-of-range values still print
+// something useful instead of panicking on the array index.
+func (d Weekday) String() string {
+	if d < 0 || int(d) >= len(weekdayNames) {
+		return fmt.Sprintf("Weekday(%d)", int(d))
+	}
+	return weekdayNames[d]
+}
+
+// IsWeekend reports whether d is Saturday or Sunday
+func (d Weekday) IsWeekend() bool {
+	return d == Saturday || d == Sunday
+}
+
+// Permission is a set of bit flags
+type Permission uint8
+
+const (
+	PermRead    Permission = 1 << iota // 1 (0b001)
+	PermWrite                          // 2 (0b010)
+	PermExecute                        // 4 (0b100)
+)
+
+// Has reports whether every flag in q is set in p
+func (p Permission) Has(q Permission) bool {
+	return p&q == q
+}
+
+// String lists the set flags, e.g. "read|write", or "none"
+func (p Permission) String() string {
+	var names []string
+	for _, f := range []struct {
+		flag Permission
+		name string
+	}{{PermRead, "read"}, {PermWrite, "write"}, {PermExecute, "execute"}} {
+		if p.Has(f.flag) {
+			names = append(names, f.name)
+			p &^= f.flag
+		}
+	}
+	if p != 0 {
+		names = append(names, fmt.Sprintf("0x%x", uint8(p))) // unknown bits
+	}
+	if len(names) == 0 {
+		return "none"
+	}
+	return strings.Join(names, "|")
+}
+
+// ByteSize shows iota inside an expression, skipping the first value
+type ByteSize int64
+
+const (
+	_           = iota             // skip 0: 1 << 0 would be 1 byte
+	KB ByteSize = 1 << (10 * iota) // 1 << 10
+	MB                             // 1 << 20
+	GB                             // 1 << 30
+)
+
+// RunConstants runs the constants and iota examples
+func RunConstants(w io.Writer) {
+	topicBanner("CONSTANTS AND IOTA IN GO", w)
+
+	heading(w, "ENUMS WITH iota")
+	for d := Sunday; d <= Saturday; d++ {
+		fmt.Fprintf(w, "  %d = %-9v weekend? %v\n", int(d), d, d.IsWeekend())
+	}
+	fmt.Fprintf(w, "Out of range: %v\n", Weekday(9))
+	fmt.Fprintf(w, "%%v uses String(): %v, %%d shows the number: %d\n", Friday, Friday)
+
+	heading(w, "BIT FLAGS WITH 1 << iota")
+	fmt.Fprintf(w, "PermRead=%d PermWrite=%d PermExecute=%d\n", PermRead, PermWrite, PermExecute)
+	perm := PermRead | PermWrite // combine with |
+	fmt.Fprintf(w, "PermRead|PermWrite = %03b (%v)\n", uint8(perm), perm)
+	fmt.Fprintf(w, "  Has(PermWrite)? %v, Has(PermExecute)? %v\n", perm.Has(PermWrite), perm.Has(PermExecute))
+	perm |= PermExecute // add a flag
+	fmt.Fprintf(w, "After |= PermExecute: %v\n", perm)
+	perm &^= PermWrite // clear a flag (AND NOT)
+	fmt.Fprintf(w, "After &^= PermWrite:  %v\n", perm)
+
+	heading(w, "IOTA IN EXPRESSIONS")
+	fmt.Fprintf(w, "KB=%d MB=%d GB=%d\n", KB, MB, GB)
+
+	// Untyped constants have arbitrary precision until they're used,
+	// and take on whatever type the context needs
+	const huge = 1 << 100 // fine as a constant...
+	fmt.Fprintf(w, "huge >> 98 = %d (1<<100 doesn't fit any int type, but the constant is exact)\n", huge>>98)
+	var f float32 = huge // ...and usable wherever it fits
+	fmt.Fprintf(w, "As float32: %g\n", f)
+
+	StatusEnumJSON(w)
+}
@@ -0,0 +1,58 @@
+package datastructures
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestWeekdayString(t *testing.T) {
+	tests := []struct {
+		d    Weekday
+		want string
+	}{
+		{Sunday, "Sunday"},
+		{Wednesday, "Wednesday"},
+		{Saturday, "Saturday"},
+		{Weekday(7), "Weekday(7)"},
+		{Weekday(-1), "Weekday(-1)"},
+	}
+	for _, tt := range tests {
+		if got := tt.d.String(); got != tt.want {
+			t.Errorf("Weekday(%d).String() = %q, want %q", int(tt.d), got, tt.want)
+		}
+	}
+	if got := fmt.Sprint(Friday); got != "Friday" {
+		t.Errorf("fmt.Sprint(Friday) = %q, want it to use String()", got)
+	}
+}
+
+func TestPermissionFlags(t *testing.T) {
+	if PermRead != 1 || PermWrite != 2 || PermExecute != 4 {
+		t.Fatalf("flags = %d, %d, %d; want 1, 2, 4", PermRead, PermWrite, PermExecute)
+	}
+
+	p := PermRead | PermExecute
+	if !p.Has(PermRead) || !p.Has(PermExecute) || p.Has(PermWrite) {
+		t.Errorf("%v: Has reports the wrong flags", p)
+	}
+	if !p.Has(PermRead|PermExecute) || p.Has(PermRead|PermWrite) {
+		t.Errorf("%v: Has with combined flags must require all of them", p)
+	}
+	if p&^PermRead != PermExecute {
+		t.Errorf("clearing PermRead from %v gave %v", p, p&^PermRead)
+	}
+
+	for _, tt := range []struct {
+		p    Permission
+		want string
+	}{
+		{0, "none"},
+		{PermWrite, "write"},
+		{PermRead | PermWrite | PermExecute, "read|write|execute"},
+		{PermRead | 0x10, "read|0x10"},
+	} {
+		if got := tt.p.String(); got != tt.want {
+			t.Errorf("Permission(%d).String() = %q, want %q", tt.p, got, tt.want)
+		}
+	}
+}
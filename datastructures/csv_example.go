@@ -0,0 +1,113 @@
+package datastructures
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// CSV IMPORT / EXPORT
+// ===================
+// encoding/csv turns [][]string into CSV text and back; mapping rows to
+// structs is up to us:
+// - The first row is a header naming the columns
+// - Fields containing commas, quotes, or newlines are quoted automatically
+//   on write ("New York, NY") and unquoted on read
+// - Everything is a string in CSV, so numbers go through strconv
+
+// personCSVHeader is the header row WritePeopleCSV writes and
+// ReadPeopleCSV expects
+var personCSVHeader = []string{"name", "age", "city"}
+
+// WritePeopleCSV writes a header row and one row per person to out
+func WritePeopleCSV(out io.Writer, people []Person) error {
+	cw := csv.NewWriter(out)
+	if err := cw.Write(personCSVHeader); err != nil {
+		return err
+	}
+	for _, p := range people {
+		if err := cw.Write([]string{p.Name, strconv.Itoa(p.Age), p.City}); err != nil {
+			return err
+		}
+	}
+	// csv.Writer buffers; Flush writes it out and Error reports failures
+	cw.Flush()
+	return cw.Error()
+}
+
+// ReadPeopleCSV parses what WritePeopleCSV writes. Empty input (not even
+// a header) is not an error: it returns no people.
+func ReadPeopleCSV(in io.Reader) ([]Person, error) {
+	cr := csv.NewReader(in)
+	cr.FieldsPerRecord = len(personCSVHeader) // every row must have 3 fields
+
+	header, err := cr.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Equal(header, personCSVHeader) {
+		return nil, fmt.Errorf("unexpected header %q, want %q", header, personCSVHeader)
+	}
+
+	var people []Person
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return people, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		age, err := strconv.Atoi(record[1])
+		if err != nil {
+			line, _ := cr.FieldPos(1)
+			return nil, fmt.Errorf("line %d: bad age %q", line, record[1])
+		}
+		people = append(people, Person{Name: record[0], Age: age, City: record[2]})
+	}
+}
+
+// RunCSV runs the CSV examples
+func RunCSV(w io.Writer) {
+	topicBanner("CSV IMPORT/EXPORT IN GO", w)
+
+	heading(w, "WRITING []Person TO CSV")
+	people := []Person{
+		{Name: "Alice", Age: 30, City: "Springfield"},
+		{Name: "Bob", Age: 25, City: "New York, NY"},
+		{Name: `Carol "CJ" Jones`, Age: 41, City: "Oslo"},
+	}
+	var sb strings.Builder
+	if err := WritePeopleCSV(&sb, people); err != nil {
+		fmt.Fprintf(w, "write error: %v\n", err)
+		return
+	}
+	fmt.Fprint(w, sb.String())
+	fmt.Fprintln(w, "  (the comma in \"New York, NY\" and the quotes around CJ are escaped by quoting)")
+
+	heading(w, "READING IT BACK")
+	decoded, err := ReadPeopleCSV(strings.NewReader(sb.String()))
+	if err != nil {
+		fmt.Fprintf(w, "read error: %v\n", err)
+		return
+	}
+	for _, p := range decoded {
+		fmt.Fprintf(w, "  %+v\n", plainPerson(p))
+	}
+	fmt.Fprintf(w, "Round trip equal? %v\n", slices.Equal(people, decoded))
+
+	heading(w, "EDGE CASES")
+	empty, err := ReadPeopleCSV(strings.NewReader(""))
+	fmt.Fprintf(w, "Empty input: %d people, err=%v\n", len(empty), err)
+	_, err = ReadPeopleCSV(strings.NewReader("name,age,city\nDave,forty,Rome\n"))
+	fmt.Fprintf(w, "Bad age: err=%v\n", err)
+	_, err = ReadPeopleCSV(strings.NewReader("name,age,city\nEve,29\n"))
+	fmt.Fprintf(w, "Missing field: err=%v\n", err)
+}
@@ -0,0 +1,49 @@
+package datastructures
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestPeopleCSVRoundTrip(t *testing.T) {
+	people := []Person{
+		{Name: "Alice", Age: 30, City: "Springfield"},
+		{Name: "Bob", Age: 25, City: "New York, NY"},
+		{Name: `Carol "CJ"`, Age: 0, City: ""},
+	}
+
+	var sb strings.Builder
+	if err := WritePeopleCSV(&sb, people); err != nil {
+		t.Fatalf("WritePeopleCSV: %v", err)
+	}
+	if !strings.Contains(sb.String(), `"New York, NY"`) {
+		t.Errorf("city with a comma was not quoted:\n%s", sb.String())
+	}
+
+	got, err := ReadPeopleCSV(strings.NewReader(sb.String()))
+	if err != nil {
+		t.Fatalf("ReadPeopleCSV: %v", err)
+	}
+	if !slices.Equal(got, people) {
+		t.Errorf("round trip:\n got  %+v\n want %+v", got, people)
+	}
+}
+
+func TestReadPeopleCSVEdgeCases(t *testing.T) {
+	if got, err := ReadPeopleCSV(strings.NewReader("")); err != nil || got != nil {
+		t.Errorf("empty input: got %v, %v; want nil, nil", got, err)
+	}
+	if got, err := ReadPeopleCSV(strings.NewReader("name,age,city\n")); err != nil || len(got) != 0 {
+		t.Errorf("header only: got %v, %v; want no people, nil", got, err)
+	}
+	for _, input := range []string{
+		"id,age,city\nAlice,30,NYC\n",    // wrong header
+		"name,age,city\nAlice,old,NYC\n", // bad number
+		"name,age,city\nAlice,30\n",      // missing field
+	} {
+		if _, err := ReadPeopleCSV(strings.NewReader(input)); err == nil {
+			t.Errorf("ReadPeopleCSV(%q): expected an error", input)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package datastructures
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// DEEP COPY
+// =========
+// Assigning a struct copies its fields, but a slice or map field is only
+// a header pointing at shared data. A deep copy duplicates that data too.
+// - DeepCopyPerson: hand-written, fast, type-specific
+// - DeepCopy[T]: generic, reflection-based, works for any type
+
+// DeepCopyPerson returns a copy of p whose Hobbies slice is independent
+func DeepCopyPerson(p PersonWithHobbies) PersonWithHobbies {
+	cp := p // copies Name and the slice HEADER (still shared)
+	if p.Hobbies != nil {
+		cp.Hobbies = make([]string, len(p.Hobbies))
+		copy(cp.Hobbies, p.Hobbies) // new backing array
+	}
+	return cp
+}
+
+// DeepCopy recursively copies slices, maps, pointers, arrays, and struct
+// fields so the result shares no mutable data with src. Unexported struct
+// fields can't be set via reflection and are copied shallowly.
+func DeepCopy[T any](src T) T {
+	v := reflect.ValueOf(&src).Elem()
+	return deepCopyValue(v).Interface().(T)
+}
+
+func deepCopyValue(src reflect.Value) reflect.Value {
+	dst := reflect.New(src.Type()).Elem()
+
+	switch src.Kind() {
+	case reflect.Slice:
+		if src.IsNil() {
+			return dst // keep nil as nil
+		}
+		dst.Set(reflect.MakeSlice(src.Type(), src.Len(), src.Len()))
+		for i := 0; i < src.Len(); i++ {
+			dst.Index(i).Set(deepCopyValue(src.Index(i)))
+		}
+	case reflect.Map:
+		if src.IsNil() {
+			return dst
+		}
+		dst.Set(reflect.MakeMapWithSize(src.Type(), src.Len()))
+		iter := src.MapRange()
+		for iter.Next() {
+			dst.SetMapIndex(iter.Key(), deepCopyValue(iter.Value()))
+		}
+	case reflect.Pointer:
+		if src.IsNil() {
+			return dst
+		}
+		ptr := reflect.New(src.Elem().Type())
+		ptr.Elem().Set(deepCopyValue(src.Elem()))
+		dst.Set(ptr)
+	case reflect.Array:
+		for i := 0; i < src.Len(); i++ {
+			dst.Index(i).Set(deepCopyValue(src.Index(i)))
+		}
+	case reflect.Struct:
+		dst.Set(src) // shallow copy first (covers unexported fields)
+		for i := 0; i < src.NumField(); i++ {
+			if dst.Field(i).CanSet() {
+				dst.Field(i).Set(deepCopyValue(src.Field(i)))
+			}
+		}
+	case reflect.Interface:
+		if src.IsNil() {
+			return dst
+		}
+		dst.Set(deepCopyValue(src.Elem()))
+	default:
+		dst.Set(src) // numbers, strings, bools... are values already
+	}
+	return dst
+}
+
+// StructDeepCopy demonstrates shallow vs deep copies of structs with slices
+func StructDeepCopy(w io.Writer) {
+	heading(w, "DEEP COPY (Structs with slices and maps)")
+
+	original := PersonWithHobbies{Name: "Alice", Hobbies: []string{"reading", "chess"}}
+
+	// Shallow copy: both Hobbies headers point at the same backing array
+	shallow := original
+	shallow.Hobbies[0] = "SKIING"
+	fmt.Fprintf(w, "Shallow copy changed Hobbies[0]: original=%v (MODIFIED!)\n", original.Hobbies)
+	original.Hobbies[0] = "reading"
+
+	// Hand-written deep copy
+	deep := DeepCopyPerson(original)
+	deep.Hobbies[0] = "SKIING"
+	deep.Hobbies = append(deep.Hobbies, "golf")
+	fmt.Fprintf(w, "DeepCopyPerson: original=%v, copy=%v\n", original.Hobbies, deep.Hobbies)
+	fmt.Fprintf(w, "  Backing arrays: original=%p, copy=%p (different)\n",
+		&original.Hobbies[0], &deep.Hobbies[0])
+
+	// Generic reflection-based deep copy handles maps and nesting too
+	type Team struct {
+		Members []PersonWithHobbies
+		Scores  map[string]int
+	}
+	team := Team{
+		Members: []PersonWithHobbies{original},
+		Scores:  map[string]int{"Alice": 10},
+	}
+	clone := DeepCopy(team)
+	clone.Members[0].Hobbies[1] = "poker"
+	clone.Scores["Alice"] = 99
+	fmt.Fprintf(w, "DeepCopy[Team]: original=%+v\n", team)
+	fmt.Fprintf(w, "                clone=%+v\n", clone)
+}
@@ -0,0 +1,72 @@
+package datastructures
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDeepCopyPersonBackingArray(t *testing.T) {
+	orig := PersonWithHobbies{Name: "Alice", Hobbies: []string{"reading", "chess"}}
+	cp := DeepCopyPerson(orig)
+
+	if &cp.Hobbies[0] == &orig.Hobbies[0] {
+		t.Fatal("copy shares the Hobbies backing array")
+	}
+	cp.Hobbies[0] = "SKIING"
+	if orig.Hobbies[0] != "reading" {
+		t.Errorf("writing the copy changed the original: %v", orig.Hobbies)
+	}
+
+	if got := DeepCopyPerson(PersonWithHobbies{Name: "Nil"}); got.Hobbies != nil {
+		t.Errorf("nil Hobbies copied as %#v, want nil", got.Hobbies)
+	}
+}
+
+func TestDeepCopyIndependent(t *testing.T) {
+	type team struct {
+		Members []PersonWithHobbies
+		Scores  map[string]int
+		Lead    *Person
+		Nested  map[string][]int
+	}
+	orig := team{
+		Members: []PersonWithHobbies{{Name: "Alice", Hobbies: []string{"chess"}}},
+		Scores:  map[string]int{"Alice": 10},
+		Lead:    &Person{Name: "Alice"},
+		Nested:  map[string][]int{"a": {1, 2}},
+	}
+	cp := DeepCopy(orig)
+
+	if !reflect.DeepEqual(cp, orig) {
+		t.Fatalf("copy = %+v, want equal contents to %+v", cp, orig)
+	}
+
+	// Slices: different backing arrays, at every level
+	if &cp.Members[0] == &orig.Members[0] {
+		t.Error("Members shares its backing array")
+	}
+	if &cp.Members[0].Hobbies[0] == &orig.Members[0].Hobbies[0] {
+		t.Error("Members[0].Hobbies shares its backing array")
+	}
+	if &cp.Nested["a"][0] == &orig.Nested["a"][0] {
+		t.Error("slice inside a map shares its backing array")
+	}
+	// Maps and pointers: different underlying objects
+	if reflect.ValueOf(cp.Scores).UnsafePointer() == reflect.ValueOf(orig.Scores).UnsafePointer() {
+		t.Error("Scores is the same map")
+	}
+	if cp.Lead == orig.Lead {
+		t.Error("Lead points at the same Person")
+	}
+
+	// And mutating the copy leaves the original alone
+	cp.Members[0].Hobbies[0] = "poker"
+	cp.Scores["Alice"] = 99
+	cp.Scores["Bob"] = 1
+	cp.Lead.Name = "Bob"
+	cp.Nested["a"][0] = 100
+	if orig.Members[0].Hobbies[0] != "chess" || orig.Scores["Alice"] != 10 || len(orig.Scores) != 1 ||
+		orig.Lead.Name != "Alice" || orig.Nested["a"][0] != 1 {
+		t.Errorf("mutating the copy changed the original: %+v", orig)
+	}
+}
@@ -0,0 +1,61 @@
+package datastructures
+
+import (
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+)
+
+// COPY SEMANTICS OF SLICE AND MAP FIELDS
+// ======================================
+// `d2 := d1` copies a struct, but a slice field copies only its header
+// (pointer, len, cap) and a map field copies only its pointer. Both
+// copies then share the same elements.
+
+// Document has reference-like fields that a plain copy shares
+type Document struct {
+	Title string
+	Tags  []string
+	Meta  map[string]string
+}
+
+// Copy returns a deep copy: the Tags and Meta of the result are independent
+func (d Document) Copy() Document {
+	cp := d
+	cp.Tags = slices.Clone(d.Tags) // new backing array (nil stays nil)
+	cp.Meta = maps.Clone(d.Meta)   // new hash table (nil stays nil)
+	return cp
+}
+
+// StructCopySemantics demonstrates shallow struct copies vs Copy()
+func StructCopySemantics(w io.Writer) {
+	heading(w, "COPY SEMANTICS: SLICE AND MAP FIELDS")
+
+	newDoc := func() Document {
+		return Document{
+			Title: "Draft",
+			Tags:  []string{"go", "tutorial"},
+			Meta:  map[string]string{"author": "alice"},
+		}
+	}
+
+	// Plain assignment: Title is independent, Tags and Meta are shared
+	original := newDoc()
+	shallow := original
+	shallow.Title = "Final"
+	shallow.Tags[0] = "GOLANG"
+	shallow.Meta["author"] = "bob"
+	fmt.Fprintln(w, "Plain copy (shallow := original), then modified the copy:")
+	fmt.Fprintf(w, "  original: %+v (Tags and Meta CHANGED!)\n", original)
+	fmt.Fprintf(w, "  shallow:  %+v\n", shallow)
+
+	// Copy(): every field is independent
+	original = newDoc()
+	deep := original.Copy()
+	deep.Tags[0] = "GOLANG"
+	deep.Meta["author"] = "bob"
+	fmt.Fprintln(w, "Deep copy (deep := original.Copy()), then modified the copy:")
+	fmt.Fprintf(w, "  original: %+v (unchanged)\n", original)
+	fmt.Fprintf(w, "  deep:     %+v\n", deep)
+}
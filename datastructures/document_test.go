@@ -0,0 +1,66 @@
+package datastructures
+
+import (
+	"maps"
+	"slices"
+	"testing"
+)
+
+func newTestDocument() Document {
+	return Document{
+		Title: "Draft",
+		Tags:  []string{"go", "tutorial"},
+		Meta:  map[string]string{"author": "alice"},
+	}
+}
+
+func TestDocumentShallowCopyShares(t *testing.T) {
+	original := newTestDocument()
+	shallow := original
+	shallow.Title = "Final"
+	shallow.Tags[0] = "GOLANG"
+	shallow.Meta["author"] = "bob"
+	shallow.Meta["status"] = "done"
+
+	if original.Title != "Draft" {
+		t.Errorf("original.Title = %q, want %q (strings are values)", original.Title, "Draft")
+	}
+	if want := []string{"GOLANG", "tutorial"}; !slices.Equal(original.Tags, want) {
+		t.Errorf("original.Tags = %v, want %v (shared backing array)", original.Tags, want)
+	}
+	if want := map[string]string{"author": "bob", "status": "done"}; !maps.Equal(original.Meta, want) {
+		t.Errorf("original.Meta = %v, want %v (shared map)", original.Meta, want)
+	}
+}
+
+func TestDocumentCopyIsIndependent(t *testing.T) {
+	original := newTestDocument()
+	deep := original.Copy()
+	deep.Tags[0] = "GOLANG"
+	deep.Tags = append(deep.Tags, "extra")
+	deep.Meta["author"] = "bob"
+	deep.Meta["status"] = "done"
+
+	if want := []string{"go", "tutorial"}; !slices.Equal(original.Tags, want) {
+		t.Errorf("original.Tags = %v after changing the copy, want %v", original.Tags, want)
+	}
+	if want := map[string]string{"author": "alice"}; !maps.Equal(original.Meta, want) {
+		t.Errorf("original.Meta = %v after changing the copy, want %v", original.Meta, want)
+	}
+
+	// The other direction: changing the original leaves the copy alone
+	original = newTestDocument()
+	deep = original.Copy()
+	original.Tags[1] = "changed"
+	original.Meta["author"] = "carol"
+	if deep.Tags[1] != "tutorial" || deep.Meta["author"] != "alice" {
+		t.Errorf("copy = %+v after changing the original, want it unchanged", deep)
+	}
+}
+
+func TestDocumentCopyKeepsNil(t *testing.T) {
+	cp := Document{Title: "Empty"}.Copy()
+	if cp.Tags != nil || cp.Meta != nil {
+		t.Errorf("Copy() of nil fields = Tags %#v, Meta %#v, want both nil", cp.Tags, cp.Meta)
+	}
+}
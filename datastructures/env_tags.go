@@ -0,0 +1,121 @@
+package datastructures
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// STRUCT TAGS → ENVIRONMENT VARIABLES
+// ===================================
+// The same idea as RegisterFlags (flag_tags.go), for twelve-factor style
+// configuration: `env:"PORT" default:"8080"`
+// - env names the variable; untagged fields are left alone
+// - default is used when the variable is unset or empty
+// - Values are strings, so ints and bools are parsed with strconv and a
+//   malformed value is an error rather than a silent zero
+
+// EnvConfig is loaded from the environment via tags
+type EnvConfig struct {
+	Host  string `env:"HOST" default:"localhost"`
+	Port  int    `env:"PORT" default:"8080"`
+	Debug bool   `env:"DEBUG" default:"false"`
+}
+
+// LoadFromEnv fills the tagged fields of the struct pointed to by v from
+// environment variables. Supported field types: string, ints, bool.
+// Every problem is reported, joined into one error.
+func LoadFromEnv(v interface{}) error {
+	return loadFromEnv(v, os.LookupEnv)
+}
+
+// loadFromEnv takes the lookup function as a parameter so the demo can
+// use a fake environment instead of changing the real one
+func loadFromEnv(v interface{}, lookup func(string) (string, bool)) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("load from env: expected pointer to struct, got %T", v)
+	}
+	rv = rv.Elem()
+	rt := rv.Type()
+
+	var errs []error
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		name, ok := field.Tag.Lookup("env")
+		if !ok {
+			continue
+		}
+		if !field.IsExported() {
+			// reflect can read an unexported field but never set it
+			errs = append(errs, fmt.Errorf("%s (field %s): field is unexported", name, field.Name))
+			continue
+		}
+		raw, ok := lookup(name)
+		if !ok || raw == "" {
+			if raw, ok = field.Tag.Lookup("default"); !ok {
+				continue // no value and no default: keep the current value
+			}
+		}
+		if err := setFromString(rv.Field(i), raw); err != nil {
+			errs = append(errs, fmt.Errorf("%s (field %s): %w", name, field.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// setFromString parses raw according to the kind of v and stores it
+func setFromString(v reflect.Value, raw string) error {
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(raw)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(raw, 10, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("invalid integer %q", raw)
+		}
+		v.SetInt(n)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return fmt.Errorf("invalid boolean %q", raw)
+		}
+		v.SetBool(b)
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+	return nil
+}
+
+// StructTagsEnv demonstrates loading configuration from the environment
+func StructTagsEnv(w io.Writer) {
+	heading(w, "STRUCT TAGS DRIVING ENVIRONMENT CONFIG")
+
+	// A fake environment keeps the demo from touching the real one;
+	// LoadFromEnv does the same with os.LookupEnv
+	envs := []map[string]string{
+		{},
+		{"HOST": "0.0.0.0", "PORT": "9090", "DEBUG": "true"},
+		{"PORT": "eighty", "DEBUG": "maybe"},
+	}
+	for _, env := range envs {
+		var cfg EnvConfig
+		err := loadFromEnv(&cfg, func(k string) (string, bool) {
+			v, ok := env[k]
+			return v, ok
+		})
+		fmt.Fprintf(w, "env %v:\n", env)
+		if err != nil {
+			// errors.Join puts each problem on its own line
+			for _, line := range strings.Split(err.Error(), "\n") {
+				fmt.Fprintf(w, "  %s %s\n", failMark(w), line)
+			}
+			continue
+		}
+		fmt.Fprintf(w, "  %+v\n", cfg)
+	}
+}
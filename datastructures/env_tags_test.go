@@ -0,0 +1,75 @@
+package datastructures
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLoadFromEnv(t *testing.T) {
+	t.Setenv("HOST", "example.com")
+	t.Setenv("PORT", "9090")
+	t.Setenv("DEBUG", "true")
+
+	var cfg EnvConfig
+	if err := LoadFromEnv(&cfg); err != nil {
+		t.Fatalf("LoadFromEnv: %v", err)
+	}
+	want := EnvConfig{Host: "example.com", Port: 9090, Debug: true}
+	if cfg != want {
+		t.Errorf("got %+v, want %+v", cfg, want)
+	}
+}
+
+func TestLoadFromEnvDefaults(t *testing.T) {
+	t.Setenv("HOST", "") // empty counts as unset
+	t.Setenv("PORT", "")
+	t.Setenv("DEBUG", "")
+
+	var cfg EnvConfig
+	if err := LoadFromEnv(&cfg); err != nil {
+		t.Fatalf("LoadFromEnv: %v", err)
+	}
+	want := EnvConfig{Host: "localhost", Port: 8080, Debug: false}
+	if cfg != want {
+		t.Errorf("got %+v, want defaults %+v", cfg, want)
+	}
+}
+
+func TestLoadFromEnvErrors(t *testing.T) {
+	t.Setenv("HOST", "")
+	t.Setenv("PORT", "eighty")
+	t.Setenv("DEBUG", "maybe")
+
+	var cfg EnvConfig
+	err := LoadFromEnv(&cfg)
+	if err == nil {
+		t.Fatal("LoadFromEnv accepted a malformed PORT")
+	}
+	for _, want := range []string{`PORT (field Port): invalid integer "eighty"`, `DEBUG (field Debug): invalid boolean "maybe"`} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q does not mention %q", err, want)
+		}
+	}
+
+	if err := LoadFromEnv(cfg); err == nil {
+		t.Error("LoadFromEnv accepted a non-pointer")
+	}
+}
+
+func TestLoadFromEnvUnexportedField(t *testing.T) {
+	type config struct {
+		Host   string `env:"HOST"`
+		secret string `env:"SECRET" default:"hunter2"`
+	}
+	env := map[string]string{"HOST": "example.com", "SECRET": "s3cret"}
+	lookup := func(k string) (string, bool) { v, ok := env[k]; return v, ok }
+
+	var cfg config
+	err := loadFromEnv(&cfg, lookup) // must not panic
+	if err == nil || !strings.Contains(err.Error(), "SECRET (field secret): field is unexported") {
+		t.Errorf("loadFromEnv with an unexported tagged field = %v, want an unexported-field error", err)
+	}
+	if cfg.Host != "example.com" || cfg.secret != "" {
+		t.Errorf("cfg = %+v, want Host loaded and secret untouched", cfg)
+	}
+}
@@ -0,0 +1,26 @@
+package datastructures
+
+import "fmt"
+
+// Testable examples: go test runs each ExampleXxx and compares what it
+// prints with the // Output: comment. They also show up in go doc
+// next to the method they're named after (Type_Method).
+
+func ExamplePerson_String() {
+	p := Person{Name: "Alice", Age: 30, City: "NYC"}
+	fmt.Println(p)
+	fmt.Printf("%v and %s both call String()\n", p, p)
+	// Output:
+	// Alice (30, NYC)
+	// Alice (30, NYC) and Alice (30, NYC) both call String()
+}
+
+func ExamplePoint_Distance() {
+	fmt.Println(Point{X: 3, Y: 4}.Distance())
+	fmt.Printf("%.3f\n", Point{X: 1, Y: 1}.Distance())
+	fmt.Println(Point{X: -6, Y: 8}.Distance())
+	// Output:
+	// 5
+	// 1.414
+	// 10
+}
@@ -0,0 +1,99 @@
+package datastructures
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"time"
+)
+
+// FINALIZERS (and why not to rely on them)
+// ========================================
+// runtime.SetFinalizer(obj, fn) asks the GC to call fn(obj) some time
+// after obj becomes unreachable. It sounds like a destructor, but:
+// - There is NO guarantee it ever runs (e.g. the program exits first)
+// - It runs on a single background goroutine, at an unpredictable time
+// - It delays freeing the object by at least one extra GC cycle
+// So: always release resources explicitly with Close() (usually via
+// defer), and use a finalizer at most as a safety net that reports leaks.
+
+// Resource simulates something that must be released (file, socket, ...)
+type Resource struct {
+	Name   string
+	closed bool
+	onLeak func(name string) // called from the finalizer
+}
+
+// OpenResource creates a Resource with a finalizer that reports the leak
+// on stderr if the Resource is never closed. Not on the tutorial's own
+// writer: the finalizer runs on the GC's goroutine, possibly after the
+// caller has finished with (or closed) that writer.
+func OpenResource(name string) *Resource {
+	return openResource(name, func(name string) {
+		fmt.Fprintf(os.Stderr, "WARNING: resource %q was garbage collected without Close()\n", name)
+	})
+}
+
+// openResource lets the demo and tests observe leaks. onLeak runs on the
+// finalizer goroutine, so it must not block: every finalizer in the
+// program waits behind it.
+func openResource(name string, onLeak func(name string)) *Resource {
+	r := &Resource{Name: name, onLeak: onLeak}
+	runtime.SetFinalizer(r, func(r *Resource) {
+		r.onLeak(r.Name)
+	})
+	return r
+}
+
+// Close releases the resource and unregisters the finalizer,
+// so a properly closed resource never triggers the warning
+func (r *Resource) Close() error {
+	if r.closed {
+		return fmt.Errorf("resource %q already closed", r.Name)
+	}
+	r.closed = true
+	runtime.SetFinalizer(r, nil) // nil removes the finalizer
+	return nil
+}
+
+// MemoryFinalizers demonstrates finalizers as a leak detector
+func MemoryFinalizers(w io.Writer) {
+	heading(w, "FINALIZERS (runtime.SetFinalizer)")
+
+	// Leak reports arrive from the finalizer goroutine. They go through a
+	// buffered channel and are printed here, on this goroutine, so
+	// nothing writes to w after MemoryFinalizers returns. A full channel
+	// drops the report rather than blocking the finalizer.
+	leaks := make(chan string, 4)
+	report := func(name string) {
+		select {
+		case leaks <- name:
+		default:
+		}
+	}
+
+	// The right way: explicit, deterministic cleanup
+	func() {
+		r := openResource("db-conn", report)
+		defer r.Close()
+		fmt.Fprintf(w, "  Using %q, closed by defer - no warning will follow\n", r.Name)
+	}()
+
+	// The leak: nothing calls Close(), so only the finalizer notices
+	func() {
+		r := openResource("leaked-file", report)
+		fmt.Fprintf(w, "  Using %q and forgetting to Close()\n", r.Name)
+	}()
+
+	// Forcing a GC makes the finalizer LIKELY to run soon, not certain.
+	// That's also why tests can't deterministically check the warning.
+	runtime.GC()
+	select {
+	case name := <-leaks:
+		fmt.Fprintf(w, "  WARNING: resource %q was garbage collected without Close()\n", name)
+	case <-time.After(50 * time.Millisecond): // give the finalizer goroutine a chance
+		fmt.Fprintln(w, "  (No WARNING yet: the finalizer simply hasn't run -")
+		fmt.Fprintln(w, "   which is exactly why Close() must not be left to the GC)")
+	}
+}
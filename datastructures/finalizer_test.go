@@ -0,0 +1,47 @@
+package datastructures
+
+import (
+	"runtime"
+	"testing"
+	"time"
+)
+
+// Only the Close path can be tested reliably. The leak path depends on
+// the GC: the runtime promises neither WHEN a finalizer runs nor THAT it
+// runs at all (runtime.GC makes it likely, not certain), so a test
+// waiting for the warning would be flaky. Asserting that a warning does
+// NOT arrive is sound, though: with the finalizer removed, nothing can
+// ever send one.
+func TestResourceCloseUnregistersFinalizer(t *testing.T) {
+	leaks := make(chan string, 1)
+	func() {
+		r := openResource("closed", func(name string) {
+			select {
+			case leaks <- name:
+			default:
+			}
+		})
+		if err := r.Close(); err != nil {
+			t.Fatalf("Close: %v", err)
+		}
+	}() // r is unreachable from here on
+
+	for range 3 {
+		runtime.GC()
+	}
+	select {
+	case name := <-leaks:
+		t.Errorf("finalizer reported %q as leaked after Close()", name)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestResourceDoubleClose(t *testing.T) {
+	r := openResource("twice", func(string) {})
+	if err := r.Close(); err != nil {
+		t.Fatalf("first Close: %v", err)
+	}
+	if err := r.Close(); err == nil {
+		t.Error("second Close succeeded, want an error")
+	}
+}
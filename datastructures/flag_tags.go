@@ -0,0 +1,92 @@
+package datastructures
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// STRUCT TAGS → CLI FLAGS
+// =======================
+// Tags aren't just for encoding/json: any code can read them with reflect.
+// RegisterFlags binds struct fields to command-line flags:
+// - `flag:"port"` names the flag (fields without the tag are skipped)
+// - `usage:"..."` provides the help text
+// - The field's current value becomes the flag's default
+
+// ServerConfig is configured from command-line flags via tags
+type ServerConfig struct {
+	Host    string `flag:"host" usage:"address to listen on"`
+	Port    int    `flag:"port" usage:"port to listen on"`
+	Verbose bool   `flag:"verbose" usage:"enable verbose logging"`
+	Secret  string // no flag tag: not configurable from the command line
+}
+
+// RegisterFlags registers a flag on fs for every tagged field of the
+// struct pointed to by v. Supported field types: string, int, bool.
+func RegisterFlags(v any, fs *flag.FlagSet) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("register flags: expected pointer to struct, got %T", v)
+	}
+	rv = rv.Elem()
+	rt := rv.Type()
+
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		name, ok := field.Tag.Lookup("flag")
+		if !ok {
+			continue
+		}
+		if !field.IsExported() {
+			return fmt.Errorf("register flags: field %s is unexported", field.Name)
+		}
+		usage := field.Tag.Get("usage")
+
+		// Addr() gives a pointer into the struct, so parsing writes the field
+		ptr := rv.Field(i).Addr().Interface()
+		switch p := ptr.(type) {
+		case *string:
+			fs.StringVar(p, name, *p, usage)
+		case *int:
+			fs.IntVar(p, name, *p, usage)
+		case *bool:
+			fs.BoolVar(p, name, *p, usage)
+		default:
+			return fmt.Errorf("register flags: field %s has unsupported type %s",
+				field.Name, field.Type)
+		}
+	}
+	return nil
+}
+
+// StructTagsFlags demonstrates configuring a struct from arguments
+func StructTagsFlags(w io.Writer) {
+	heading(w, "STRUCT TAGS DRIVING CLI FLAGS")
+
+	cfg := ServerConfig{Host: "localhost", Port: 8080} // defaults
+	fs := flag.NewFlagSet("server", flag.ContinueOnError)
+	fs.SetOutput(w)
+	if err := RegisterFlags(&cfg, fs); err != nil {
+		fmt.Fprintf(w, "Error: %v\n", err)
+		return
+	}
+
+	fmt.Fprintln(w, "Registered flags:")
+	fs.PrintDefaults()
+
+	args := []string{"-host", "0.0.0.0", "-port=9090", "-verbose"}
+	if err := fs.Parse(args); err != nil {
+		fmt.Fprintf(w, "Parse error: %v\n", err)
+		return
+	}
+	fmt.Fprintf(w, "Parsed %v:\n  %+v\n", args, cfg)
+
+	// Unsupported field types are reported instead of silently ignored
+	var bad struct {
+		Ratio float64 `flag:"ratio"`
+	}
+	err := RegisterFlags(&bad, flag.NewFlagSet("bad", flag.ContinueOnError))
+	fmt.Fprintf(w, "Unsupported field: %v\n", err)
+}
@@ -0,0 +1,75 @@
+package datastructures
+
+import (
+	"flag"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestRegisterFlagsParse(t *testing.T) {
+	cfg := ServerConfig{Host: "localhost", Port: 8080, Secret: "keep"}
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	if err := RegisterFlags(&cfg, fs); err != nil {
+		t.Fatalf("RegisterFlags: %v", err)
+	}
+	if err := fs.Parse([]string{"-host", "0.0.0.0", "-port=9090", "-verbose"}); err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	want := ServerConfig{Host: "0.0.0.0", Port: 9090, Verbose: true, Secret: "keep"}
+	if cfg != want {
+		t.Errorf("parsed config = %+v, want %+v", cfg, want)
+	}
+
+	// Untagged fields get no flag; usage tags become help text
+	if fs.Lookup("Secret") != nil || fs.Lookup("secret") != nil {
+		t.Error("untagged Secret field was registered as a flag")
+	}
+	if f := fs.Lookup("port"); f == nil || f.Usage != "port to listen on" || f.DefValue != "8080" {
+		t.Errorf("port flag = %+v, want usage from the tag and default 8080", f)
+	}
+}
+
+func TestRegisterFlagsDefaultsKept(t *testing.T) {
+	cfg := ServerConfig{Host: "localhost", Port: 8080}
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	RegisterFlags(&cfg, fs)
+	if err := fs.Parse(nil); err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if cfg.Host != "localhost" || cfg.Port != 8080 || cfg.Verbose {
+		t.Errorf("with no args, config = %+v, want the defaults", cfg)
+	}
+}
+
+func TestRegisterFlagsErrors(t *testing.T) {
+	var bad struct {
+		Ratio float64 `flag:"ratio"`
+	}
+	err := RegisterFlags(&bad, flag.NewFlagSet("bad", flag.ContinueOnError))
+	if err == nil || !strings.Contains(err.Error(), "unsupported type float64") {
+		t.Errorf("float64 field: err = %v, want an unsupported type error", err)
+	}
+
+	var hidden struct {
+		Host  string `flag:"host"`
+		token string `flag:"token"`
+	}
+	err = RegisterFlags(&hidden, flag.NewFlagSet("hidden", flag.ContinueOnError)) // must not panic
+	if err == nil || !strings.Contains(err.Error(), "field token is unexported") {
+		t.Errorf("unexported field: err = %v, want an unexported field error", err)
+	}
+
+	if err := RegisterFlags(ServerConfig{}, flag.NewFlagSet("x", flag.ContinueOnError)); err == nil {
+		t.Error("non-pointer argument: want an error")
+	}
+
+	// A bad value is reported by Parse, not silently ignored
+	var cfg ServerConfig
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	RegisterFlags(&cfg, fs)
+	if err := fs.Parse([]string{"-port", "eighty"}); err == nil {
+		t.Error("-port eighty parsed without error")
+	}
+}
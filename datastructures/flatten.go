@@ -0,0 +1,162 @@
+package datastructures
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// FLATTENING NESTED STRUCTS
+// =========================
+// Serialization formats like CSV, env vars, or query strings are flat.
+// Flatten walks a struct with reflection and produces dotted keys:
+// - Nested struct fields become "Outer.Inner"
+// - Embedded struct fields are promoted (no prefix), just like in Go
+// - `json:"name"` tags rename a key, `json:"-"` skips the field
+
+// Flatten converts a struct (or pointer to struct) into a map of dotted keys
+func Flatten(v any) (map[string]any, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil, fmt.Errorf("flatten: nil %s", rv.Type())
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("flatten: expected struct, got %s", rv.Kind())
+	}
+
+	out := make(map[string]any)
+	flattenStruct(rv, "", out)
+	return out, nil
+}
+
+func flattenStruct(rv reflect.Value, prefix string, out map[string]any) {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, skip := jsonFieldName(field)
+		if skip {
+			continue
+		}
+
+		fv := rv.Field(i)
+		for fv.Kind() == reflect.Pointer && !fv.IsNil() {
+			fv = fv.Elem()
+		}
+
+		switch {
+		case field.Anonymous && fv.Kind() == reflect.Struct:
+			// Embedded: promote fields without adding a prefix
+			flattenStruct(fv, prefix, out)
+		case fv.Kind() == reflect.Struct:
+			flattenStruct(fv, prefix+name+".", out)
+		default:
+			out[prefix+name] = fv.Interface()
+		}
+	}
+}
+
+// Unflatten rebuilds nested maps from dotted keys (the reverse of Flatten).
+// A key used both as a leaf ("a") and a branch ("a.b") is an error.
+func Unflatten(flat map[string]any) (map[string]any, error) {
+	// Sort keys so conflicts are reported deterministically, always
+	// from the branch's side (see below)
+	keys := make([]string, 0, len(flat))
+	for k := range flat {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	root := make(map[string]any)
+	for _, key := range keys {
+		parts := strings.Split(key, ".")
+		node := root
+		for i, part := range parts[:len(parts)-1] {
+			next, exists := node[part]
+			if !exists {
+				child := make(map[string]any)
+				node[part] = child
+				node = child
+				continue
+			}
+			child, isMap := next.(map[string]any)
+			if !isMap {
+				return nil, fmt.Errorf("unflatten: %q is a leaf but %q needs it as a branch",
+					strings.Join(parts[:i+1], "."), key)
+			}
+			node = child
+		}
+
+		// No need to check whether node already has this leaf as a branch:
+		// a key sorts before every key it is a prefix of ("a" < "a.b"), so
+		// the leaf is always placed first and caught by the check above
+		node[parts[len(parts)-1]] = flat[key]
+	}
+	return root, nil
+}
+
+// jsonFieldName returns the key for a field, honoring its json tag
+func jsonFieldName(field reflect.StructField) (name string, skip bool) {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", true
+	}
+	if name, _, _ = strings.Cut(tag, ","); name != "" {
+		return name, false
+	}
+	return field.Name, false
+}
+
+// StructFlatten demonstrates flattening nested structs into dotted keys
+func StructFlatten(w io.Writer) {
+	heading(w, "FLATTENING NESTED STRUCTS")
+
+	emp := Employee{
+		Person:     Person{Name: "Alice", Age: 30, City: "NYC"},
+		EmployeeID: 12345,
+		Department: "Engineering",
+	}
+	rect := Rectangle{TopLeft: Point{0, 10}, BottomRight: Point{5, 0}}
+
+	for _, v := range []any{emp, rect} {
+		flat, err := Flatten(v)
+		if err != nil {
+			fmt.Fprintf(w, "Error: %v\n", err)
+			continue
+		}
+
+		keys := make([]string, 0, len(flat))
+		for k := range flat {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys) // map order is random; sort for stable output
+
+		fmt.Fprintf(w, "%T flattened:\n", v)
+		for _, k := range keys {
+			fmt.Fprintf(w, "  %-16s = %v\n", k, flat[k])
+		}
+	}
+
+	// Non-struct input is rejected
+	if _, err := Flatten(42); err != nil {
+		fmt.Fprintf(w, "Flatten(42): %v\n", err)
+	}
+
+	// Unflatten goes the other way: dotted keys back to nested maps
+	flat, _ := Flatten(rect)
+	nested, err := Unflatten(flat)
+	fmt.Fprintf(w, "\nUnflatten(%T): %v (err=%v)\n", rect, nested, err)
+
+	conflict := map[string]any{"server": "localhost", "server.port": 8080}
+	if _, err := Unflatten(conflict); err != nil {
+		fmt.Fprintf(w, "Unflatten(%v): %v\n", conflict, err)
+	}
+}
@@ -0,0 +1,86 @@
+package datastructures
+
+import (
+	"maps"
+	"testing"
+)
+
+func TestFlattenEmployee(t *testing.T) {
+	emp := Employee{
+		Person:     Person{Name: "Alice", Age: 30, City: "NYC"},
+		EmployeeID: 12345,
+		Department: "Engineering",
+	}
+	got, err := Flatten(&emp)
+	if err != nil {
+		t.Fatalf("Flatten: %v", err)
+	}
+	want := map[string]any{
+		// promoted from the embedded Person, no "Person." prefix
+		"Name": "Alice",
+		"Age":  30,
+		"City": "NYC",
+		// Employee's own fields
+		"EmployeeID": 12345,
+		"Department": "Engineering",
+	}
+	if !maps.Equal(got, want) {
+		t.Errorf("Flatten(Employee) = %v, want %v", got, want)
+	}
+}
+
+func TestFlattenNestedAndTags(t *testing.T) {
+	got, err := Flatten(Order{ID: 1, Shipping: ShippingAddress{Street: "1 Main St", City: "Springfield"}})
+	if err != nil {
+		t.Fatalf("Flatten: %v", err)
+	}
+	// Named struct fields get a dotted prefix, and json tags rename
+	// keys at every level; the nil *ShippingAddress stays a leaf
+	for _, key := range []string{"id", "shipping.street", "shipping.city", "shipping.zip", "billing"} {
+		if _, ok := got[key]; !ok {
+			t.Errorf("Flatten(Order) has no key %q: %v", key, got)
+		}
+	}
+	if len(got) != 5 {
+		t.Errorf("Flatten(Order) has %d keys, want 5: %v", len(got), got)
+	}
+
+	if _, err := Flatten(42); err == nil {
+		t.Error("Flatten(42) succeeded, want an error for a non-struct")
+	}
+}
+
+func TestUnflatten(t *testing.T) {
+	flat, err := Flatten(Rectangle{TopLeft: Point{X: 0, Y: 10}, BottomRight: Point{X: 5, Y: 0}})
+	if err != nil {
+		t.Fatalf("Flatten: %v", err)
+	}
+	got, err := Unflatten(flat)
+	if err != nil {
+		t.Fatalf("Unflatten: %v", err)
+	}
+	want := map[string]any{
+		"TopLeft":     map[string]any{"X": 0, "Y": 10},
+		"BottomRight": map[string]any{"X": 5, "Y": 0},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Unflatten = %v, want %v", got, want)
+	}
+	for k, w := range want {
+		branch, ok := got[k].(map[string]any)
+		if !ok || !maps.Equal(branch, w.(map[string]any)) {
+			t.Errorf("Unflatten[%q] = %v, want %v", k, got[k], w)
+		}
+	}
+}
+
+func TestUnflattenConflict(t *testing.T) {
+	for _, flat := range []map[string]any{
+		{"server": "localhost", "server.port": 8080},
+		{"a.b": 1, "a.b.c": 2},
+	} {
+		if got, err := Unflatten(flat); err == nil {
+			t.Errorf("Unflatten(%v) = %v, want a leaf/branch conflict error", flat, got)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package datastructures
+
+import (
+	"container/heap"
+	"fmt"
+	"io"
+)
+
+// HEAPS AND PRIORITY QUEUES
+// =========================
+// A min-heap keeps the smallest element at index 0 of a slice, so
+// peeking is O(1) and push/pop are O(log n). container/heap supplies the
+// algorithms; you supply a type implementing heap.Interface:
+// - sort.Interface (Len, Less, Swap) plus Push(any) and Pop() any
+// - Call heap.Push/heap.Pop - NOT the methods directly, which only
+//   append/remove at the end of the slice without restoring heap order
+
+// IntHeap is a min-heap of ints
+type IntHeap []int
+
+func (h IntHeap) Len() int           { return len(h) }
+func (h IntHeap) Less(i, j int) bool { return h[i] < h[j] }
+func (h IntHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+// Push and Pop use pointer receivers because they change the length
+func (h *IntHeap) Push(x any) { *h = append(*h, x.(int)) }
+
+func (h *IntHeap) Pop() any {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
+
+// PriorityQueue is a generic heap ordered by a less function, so callers
+// don't have to implement heap.Interface themselves. Pop returns the
+// element for which less reports it comes first (the minimum for <).
+type PriorityQueue[T any] struct {
+	h pqHeap[T]
+}
+
+// pqHeap adapts a slice and a less func to heap.Interface
+type pqHeap[T any] struct {
+	items []T
+	less  func(a, b T) bool
+}
+
+func (h pqHeap[T]) Len() int           { return len(h.items) }
+func (h pqHeap[T]) Less(i, j int) bool { return h.less(h.items[i], h.items[j]) }
+func (h pqHeap[T]) Swap(i, j int)      { h.items[i], h.items[j] = h.items[j], h.items[i] }
+func (h *pqHeap[T]) Push(x any)        { h.items = append(h.items, x.(T)) }
+
+func (h *pqHeap[T]) Pop() any {
+	n := len(h.items)
+	x := h.items[n-1]
+	var zero T
+	h.items[n-1] = zero // drop the reference so it can be garbage collected
+	h.items = h.items[:n-1]
+	return x
+}
+
+// NewPriorityQueue creates an empty queue ordered by less
+func NewPriorityQueue[T any](less func(a, b T) bool) *PriorityQueue[T] {
+	return &PriorityQueue[T]{h: pqHeap[T]{less: less}}
+}
+
+// Push adds v to the queue
+func (pq *PriorityQueue[T]) Push(v T) {
+	heap.Push(&pq.h, v)
+}
+
+// Pop removes and returns the first element, or ok=false if empty
+func (pq *PriorityQueue[T]) Pop() (v T, ok bool) {
+	if pq.h.Len() == 0 {
+		return v, false
+	}
+	return heap.Pop(&pq.h).(T), true
+}
+
+// Peek returns the first element without removing it
+func (pq *PriorityQueue[T]) Peek() (v T, ok bool) {
+	if pq.h.Len() == 0 {
+		return v, false
+	}
+	return pq.h.items[0], true
+}
+
+// Len returns the number of queued elements
+func (pq *PriorityQueue[T]) Len() int {
+	return pq.h.Len()
+}
+
+// RunHeap runs the heap and priority queue examples
+func RunHeap(w io.Writer) {
+	topicBanner("HEAPS AND PRIORITY QUEUES IN GO", w)
+
+	heading(w, "MIN-HEAP WITH container/heap")
+	h := &IntHeap{5, 2, 8}
+	heap.Init(h) // establish heap order on existing elements: O(n)
+	fmt.Fprintf(w, "After Init(5, 2, 8): %v\n", *h)
+	for _, v := range []int{3, 9, 1} {
+		heap.Push(h, v)
+		fmt.Fprintf(w, "Push(%d) → %v (minimum %d at index 0)\n", v, *h, (*h)[0])
+	}
+	fmt.Fprintln(w, "  (the slice is NOT sorted - only h[0] is guaranteed to be the minimum)")
+
+	fmt.Fprint(w, "Pop until empty:")
+	for h.Len() > 0 {
+		fmt.Fprintf(w, " %d", heap.Pop(h))
+	}
+	fmt.Fprintln(w, " (ascending order)")
+
+	heading(w, "GENERIC PRIORITY QUEUE")
+	type task struct {
+		Name     string
+		Priority int // lower runs first
+	}
+	pq := NewPriorityQueue(func(a, b task) bool { return a.Priority < b.Priority })
+	for _, t := range []task{{"write docs", 3}, {"fix outage", 1}, {"review PR", 2}, {"refactor", 4}} {
+		pq.Push(t)
+	}
+	if next, ok := pq.Peek(); ok {
+		fmt.Fprintf(w, "Peek: %q (still %d queued)\n", next.Name, pq.Len())
+	}
+	for pq.Len() > 0 {
+		t, _ := pq.Pop()
+		fmt.Fprintf(w, "Pop: priority %d - %s\n", t.Priority, t.Name)
+	}
+	if _, ok := pq.Pop(); !ok {
+		fmt.Fprintln(w, "Pop on empty queue: ok=false (no panic)")
+	}
+
+	// Flipping less turns it into a max-heap
+	maxPQ := NewPriorityQueue(func(a, b int) bool { return a > b })
+	for _, v := range []int{4, 10, 7} {
+		maxPQ.Push(v)
+	}
+	top, _ := maxPQ.Peek()
+	fmt.Fprintf(w, "Max-heap via less = a > b: Peek → %d\n", top)
+}
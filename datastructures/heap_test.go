@@ -0,0 +1,82 @@
+package datastructures
+
+import (
+	"container/heap"
+	"slices"
+	"testing"
+)
+
+func TestIntHeapPopOrder(t *testing.T) {
+	h := &IntHeap{5, 2, 8}
+	heap.Init(h)
+	for _, v := range []int{3, 9, 1, 2, 7} { // includes a duplicate 2
+		heap.Push(h, v)
+	}
+	if (*h)[0] != 1 {
+		t.Errorf("minimum at index 0 = %d, want 1", (*h)[0])
+	}
+
+	var got []int
+	for h.Len() > 0 {
+		got = append(got, heap.Pop(h).(int))
+	}
+	if want := []int{1, 2, 2, 3, 5, 7, 8, 9}; !slices.Equal(got, want) {
+		t.Errorf("pop order = %v, want %v", got, want)
+	}
+}
+
+func TestPriorityQueuePopOrder(t *testing.T) {
+	minQ := NewPriorityQueue(func(a, b int) bool { return a < b })
+	maxQ := NewPriorityQueue(func(a, b int) bool { return a > b })
+	for _, v := range []int{4, 1, 7, 3, 9, 1} {
+		minQ.Push(v)
+		maxQ.Push(v)
+	}
+
+	drain := func(pq *PriorityQueue[int]) []int {
+		var out []int
+		for {
+			v, ok := pq.Pop()
+			if !ok {
+				return out
+			}
+			out = append(out, v)
+		}
+	}
+	if top, ok := minQ.Peek(); !ok || top != 1 || minQ.Len() != 6 {
+		t.Errorf("min Peek() = %d, %v with Len %d, want 1, true with Len 6 (Peek must not remove)", top, ok, minQ.Len())
+	}
+	if got, want := drain(minQ), []int{1, 1, 3, 4, 7, 9}; !slices.Equal(got, want) {
+		t.Errorf("min-queue pop order = %v, want %v", got, want)
+	}
+	if got, want := drain(maxQ), []int{9, 7, 4, 3, 1, 1}; !slices.Equal(got, want) {
+		t.Errorf("max-queue pop order = %v, want %v", got, want)
+	}
+
+	if v, ok := minQ.Pop(); ok || v != 0 {
+		t.Errorf("Pop() on empty queue = %d, %v, want 0, false", v, ok)
+	}
+	if v, ok := minQ.Peek(); ok || v != 0 {
+		t.Errorf("Peek() on empty queue = %d, %v, want 0, false", v, ok)
+	}
+}
+
+func TestPriorityQueueStructs(t *testing.T) {
+	type task struct {
+		name     string
+		priority int
+	}
+	pq := NewPriorityQueue(func(a, b task) bool { return a.priority > b.priority })
+	pq.Push(task{"write docs", 1})
+	pq.Push(task{"fix outage", 10})
+	pq.Push(task{"review PR", 5})
+
+	var got []string
+	for pq.Len() > 0 {
+		t, _ := pq.Pop()
+		got = append(got, t.name)
+	}
+	if want := []string{"fix outage", "review PR", "write docs"}; !slices.Equal(got, want) {
+		t.Errorf("pop order = %v, want %v", got, want)
+	}
+}
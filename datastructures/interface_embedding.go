@@ -0,0 +1,134 @@
+package datastructures
+
+import (
+	"fmt"
+	"io"
+)
+
+// INTERFACE EMBEDDING
+// ===================
+// Struct embedding composes data; interface embedding composes behavior.
+// ReadWriter below lists no methods of its own - it is exactly the union
+// of Reader and Writer, the same way io.ReadWriter is built in the
+// standard library.
+
+// Reader is anything that can be read from
+type Reader interface {
+	Read(p []byte) (n int, err error)
+}
+
+// Writer is anything that can be written to
+type Writer interface {
+	Write(p []byte) (n int, err error)
+}
+
+// ReadWriter combines both behaviors by embedding the two interfaces
+type ReadWriter interface {
+	Reader
+	Writer
+}
+
+// MemoryBuffer is a FIFO byte buffer: Write appends, Read consumes
+type MemoryBuffer struct {
+	data []byte
+}
+
+// Compile-time assertions: the build fails if MemoryBuffer ever stops
+// satisfying the composed interfaces
+var (
+	_ ReadWriter    = (*MemoryBuffer)(nil)
+	_ io.ReadWriter = (*MemoryBuffer)(nil)
+)
+
+// Write appends p to the buffer
+func (b *MemoryBuffer) Write(p []byte) (int, error) {
+	b.data = append(b.data, p...)
+	return len(p), nil
+}
+
+// Read consumes up to len(p) bytes, returning io.EOF once empty
+func (b *MemoryBuffer) Read(p []byte) (int, error) {
+	if len(b.data) == 0 {
+		return 0, io.EOF
+	}
+	n := copy(p, b.data)
+	b.data = b.data[n:]
+	return n, nil
+}
+
+// Named is satisfied by anything with a GetName method
+type Named interface {
+	GetName() string
+}
+
+// GetName is declared on Person only. Employee embeds Person, so
+// GetName is promoted and Employee satisfies Named as well.
+func (p Person) GetName() string {
+	return p.Name
+}
+
+// Employee never mentions GetName; these still compile because the
+// method set of Employee includes the promoted Person methods
+var (
+	_ Named = Person{}
+	_ Named = Employee{}
+	_ Named = (*Employee)(nil)
+)
+
+// StructInterfaceEmbedding demonstrates composing interfaces
+func StructInterfaceEmbedding(w io.Writer) {
+	heading(w, "INTERFACE EMBEDDING (Composing Behavior)")
+
+	buf := &MemoryBuffer{}
+
+	// *MemoryBuffer has both methods, so it is a ReadWriter
+	var rw ReadWriter = buf
+	fmt.Fprintf(rw, "hello, %s", "interfaces")
+
+	// A ReadWriter can be narrowed to either part...
+	var r Reader = rw
+	var wr Writer = rw
+	wr.Write([]byte("!"))
+
+	chunk := make([]byte, 8)
+	for {
+		n, err := r.Read(chunk)
+		if err == io.EOF {
+			break
+		}
+		fmt.Fprintf(w, "Read %d bytes: %q\n", n, chunk[:n])
+	}
+
+	// ...and it works with the standard library's io.ReadWriter too
+	var std io.ReadWriter = buf
+	io.WriteString(std, "via io.WriteString")
+	all, _ := io.ReadAll(std)
+	fmt.Fprintf(w, "io.ReadAll: %q\n", all)
+
+	// A type assertion asks "does this Reader also write?"
+	if _, ok := r.(Writer); ok {
+		fmt.Fprintln(w, "r.(Writer) succeeds: the dynamic type has Write too")
+	}
+}
+
+// StructPromotedInterface demonstrates satisfying an interface with a
+// method promoted from an embedded struct
+func StructPromotedInterface(w io.Writer) {
+	heading(w, "INTERFACES SATISFIED BY EMBEDDING")
+
+	emp := Employee{Person: Person{Name: "Bob", Age: 41}, EmployeeID: 99}
+
+	// No GetName on Employee, but the promoted Person.GetName counts
+	names := []Named{Person{Name: "Alice"}, emp, &emp}
+	for _, n := range names {
+		fmt.Fprintf(w, "%-30T GetName() = %s\n", n, n.GetName())
+	}
+	fmt.Fprintln(w, "  (Employee \"implements\" Named without writing a single method)")
+
+	// The interface holds an Employee, not a Person: the promoted
+	// method is called on the embedded field, but the dynamic type
+	// keeps everything else
+	if e, ok := names[1].(Employee); ok {
+		fmt.Fprintf(w, "names[1].(Employee) ok, EmployeeID = %d\n", e.EmployeeID)
+	}
+}
@@ -0,0 +1,64 @@
+package datastructures
+
+import (
+	"errors"
+	"io"
+	"testing"
+)
+
+func TestEmployeeSatisfiesNamed(t *testing.T) {
+	emp := Employee{Person: Person{Name: "Bob"}, EmployeeID: 99}
+
+	// Compiles only because GetName is promoted from Person
+	var n Named = emp
+	if got := n.GetName(); got != "Bob" {
+		t.Errorf("Named(emp).GetName() = %q, want %q", got, "Bob")
+	}
+	if _, ok := n.(Employee); !ok {
+		t.Errorf("dynamic type = %T, want Employee", n)
+	}
+
+	n = &emp
+	emp.Name = "Robert" // the interface holds a pointer, so it sees this
+	if got := n.GetName(); got != "Robert" {
+		t.Errorf("Named(&emp).GetName() = %q, want %q", got, "Robert")
+	}
+}
+
+func TestMemoryBufferThroughReadWriter(t *testing.T) {
+	var rw ReadWriter = &MemoryBuffer{} // both halves through one interface value
+
+	for _, s := range []string{"hello, ", "world"} {
+		if n, err := rw.Write([]byte(s)); n != len(s) || err != nil {
+			t.Fatalf("Write(%q) = %d, %v, want %d, nil", s, n, err, len(s))
+		}
+	}
+
+	// Reads consume in FIFO order, at most len(p) bytes at a time
+	p := make([]byte, 5)
+	for _, want := range []string{"hello", ", wor", "ld"} {
+		n, err := rw.Read(p)
+		if err != nil || string(p[:n]) != want {
+			t.Fatalf("Read = %q, %v, want %q, nil", p[:n], err, want)
+		}
+	}
+	if n, err := rw.Read(p); n != 0 || !errors.Is(err, io.EOF) {
+		t.Errorf("Read on empty buffer = %d, %v, want 0, io.EOF", n, err)
+	}
+
+	// Writing after draining works, and the composed interface is still
+	// an io.Reader for the standard library
+	io.WriteString(rw, "again")
+	got, err := io.ReadAll(rw)
+	if err != nil || string(got) != "again" {
+		t.Errorf("io.ReadAll = %q, %v, want %q, nil", got, err, "again")
+	}
+
+	// A ReadWriter can be narrowed to either half
+	var r Reader = rw
+	var w Writer = rw
+	w.Write([]byte("x"))
+	if n, _ := r.Read(p); string(p[:n]) != "x" {
+		t.Errorf("Read via Reader = %q, want %q", p[:n], "x")
+	}
+}
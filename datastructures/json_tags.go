@@ -0,0 +1,106 @@
+package datastructures
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// JSON TAG OPTIONS
+// ================
+// Beyond renaming, omitempty and "-", encoding/json understands:
+// - `json:"id,string"` encodes a number or bool as a JSON string
+//   ("42" instead of 42), e.g. for IDs above JavaScript's 2^53 limit
+// - Embedded structs without a tag are flattened: their fields are
+//   promoted into the outer JSON object, just like in Go
+// - Giving an embedded struct a tag nests it under that key instead
+// - Nested (named) struct fields become nested objects
+// Field name conflicts follow Go's promotion rules: the shallowest field
+// wins, and two fields at the same depth cancel each other out.
+
+// Account encodes its numbers as strings
+type Account struct {
+	ID      int64   `json:"id,string"`
+	Balance float64 `json:"balance,string"`
+	Active  bool    `json:"active,string"`
+}
+
+// TaggedEmployee nests Person under "person" instead of flattening it
+type TaggedEmployee struct {
+	Person     `json:"person"`
+	EmployeeID int `json:"employee_id"`
+}
+
+// ShippingAddress and Order show tags on nested structs
+type ShippingAddress struct {
+	Street string `json:"street"`
+	City   string `json:"city"`
+	Zip    string `json:"zip,omitempty"`
+}
+
+type Order struct {
+	ID       int              `json:"id"`
+	Shipping ShippingAddress  `json:"shipping"`
+	Billing  *ShippingAddress `json:"billing,omitempty"` // nil pointer: omitted
+}
+
+// DuplicateKeys gets two "Name" keys at the same depth from its two
+// embedded structs. (Two identical json TAGS, even via embedding, are
+// reported by go vet; untagged field names like these are not.)
+type firstName struct {
+	Name string
+}
+
+type lastName struct {
+	Name string
+}
+
+type DuplicateKeys struct {
+	firstName
+	lastName
+	Other string `json:"other"`
+}
+
+// nameLabel and DepthWins show the depth rule: the outer "name" beats
+// the embedded one
+type nameLabel struct {
+	Name string `json:"name"`
+}
+
+type DepthWins struct {
+	nameLabel
+	Name string `json:"name"`
+}
+
+// demoAdvancedTags marshals one value per tag option and prints the JSON
+func demoAdvancedTags(w io.Writer) {
+	heading(w, "ADVANCED JSON TAGS")
+
+	show := func(label string, v any) {
+		data, err := json.Marshal(v)
+		if err != nil {
+			fmt.Fprintf(w, "%s: Marshal error: %v\n", label, err)
+			return
+		}
+		fmt.Fprintf(w, "%s:\n  %s\n", label, data)
+	}
+
+	show("`,string` option", Account{ID: 9007199254740993, Balance: 12.5, Active: true})
+	fmt.Fprintln(w, "  (quoted numbers survive JavaScript clients, which lose precision above 2^53)")
+
+	emp := Employee{Person: Person{Name: "Alice", Age: 30, City: "NYC"}, EmployeeID: 7, Department: "Research"}
+	show("Embedded Person, no tag (Employee)", emp)
+	fmt.Fprintln(w, "  (Person's fields are promoted into the outer object)")
+
+	show("Embedded Person tagged `json:\"person\"`", TaggedEmployee{Person: emp.Person, EmployeeID: 7})
+	fmt.Fprintln(w, "  (a tag on the embedded field nests it like a named field)")
+
+	show("Nested struct tags (Order)", Order{ID: 1, Shipping: ShippingAddress{Street: "1 Main St", City: "Springfield"}})
+	fmt.Fprintln(w, "  (inner tags apply inside \"shipping\"; nil Billing and empty Zip omitted)")
+
+	show("Two embedded \"Name\" fields at the same depth", DuplicateKeys{firstName{"A"}, lastName{"B"}, "C"})
+	fmt.Fprintln(w, "  (ambiguous: BOTH fields are silently dropped, no error)")
+
+	show("Outer \"name\" vs embedded \"name\"", DepthWins{nameLabel: nameLabel{Name: "inner"}, Name: "outer"})
+	fmt.Fprintln(w, "  (the shallower field wins, the embedded one is hidden)")
+}
@@ -0,0 +1,81 @@
+package datastructures
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestAdvancedJSONTags(t *testing.T) {
+	person := Person{Name: "Alice", Age: 30, City: "NYC"}
+	tests := []struct {
+		name string
+		v    any
+		want string
+	}{
+		{"string option", Account{ID: 42, Balance: 1.5, Active: true},
+			`{"id":"42","balance":"1.5","active":"true"}`},
+		{"embedded untagged is flattened", Employee{Person: person, EmployeeID: 7, Department: "Research"},
+			`{"Name":"Alice","Age":30,"City":"NYC","EmployeeID":7,"Department":"Research"}`},
+		{"embedded tagged is nested", TaggedEmployee{Person: person, EmployeeID: 7},
+			`{"person":{"Name":"Alice","Age":30,"City":"NYC"},"employee_id":7}`},
+		{"nested struct tags", Order{ID: 1, Shipping: ShippingAddress{Street: "1 Main St", City: "Springfield", Zip: "12345"}},
+			`{"id":1,"shipping":{"street":"1 Main St","city":"Springfield","zip":"12345"}}`},
+		{"same-depth duplicates are dropped", DuplicateKeys{firstName{"A"}, lastName{"B"}, "C"},
+			`{"other":"C"}`},
+		{"shallower field wins", DepthWins{nameLabel{"inner"}, "outer"},
+			`{"name":"outer"}`},
+	}
+	for _, tt := range tests {
+		data, err := json.Marshal(tt.v)
+		if err != nil {
+			t.Errorf("%s: Marshal error: %v", tt.name, err)
+			continue
+		}
+		if string(data) != tt.want {
+			t.Errorf("%s:\n got  %s\n want %s", tt.name, data, tt.want)
+		}
+	}
+}
+
+func TestStringOptionRoundTrip(t *testing.T) {
+	var a Account
+	if err := json.Unmarshal([]byte(`{"id":"9007199254740993","balance":"12.5","active":"true"}`), &a); err != nil {
+		t.Fatal(err)
+	}
+	if a.ID != 9007199254740993 || a.Balance != 12.5 || !a.Active {
+		t.Errorf("decoded %+v", a)
+	}
+	// With ,string a bare number is an error
+	if err := json.Unmarshal([]byte(`{"id":42}`), &a); err == nil {
+		t.Error("expected an error decoding an unquoted number into a ,string field")
+	}
+}
+
+func TestUserJSONTags(t *testing.T) {
+	user := User{ID: 1, Name: "Alice", Password: "secret123", CreatedAt: "2024-01-15"}
+	data, err := json.Marshal(user)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	out := string(data)
+	for _, key := range []string{`"id":1`, `"name":"Alice"`, `"created_at":"2024-01-15"`} {
+		if !strings.Contains(out, key) {
+			t.Errorf("Marshal(User) = %s, want it to contain %s", out, key)
+		}
+	}
+	for _, key := range []string{`"password"`, `"Password"`, "secret123", `"email"`} {
+		if strings.Contains(out, key) {
+			t.Errorf("Marshal(User) = %s, must not contain %s", out, key)
+		}
+	}
+
+	// `json:"-"` also blocks decoding
+	var decoded User
+	if err := json.Unmarshal([]byte(`{"id":2,"password":"x","email":"b@example.com"}`), &decoded); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if decoded.ID != 2 || decoded.Email != "b@example.com" || decoded.Password != "" {
+		t.Errorf("Unmarshal = %+v, want ID 2, the email, and an empty Password", decoded)
+	}
+}
@@ -0,0 +1,116 @@
+package datastructures
+
+import (
+	"fmt"
+	"io"
+)
+
+// LINKED LISTS
+// ============
+// A singly linked list is a chain of structs connected by pointers.
+// - Each node holds a value and a pointer to the next node
+// - The last node's next pointer is nil - that's how the list ends
+// - Go's standard library has container/list (doubly linked, untyped)
+
+// node is one link in the chain
+type node[T any] struct {
+	value T
+	next  *node[T] // nil terminates the list
+}
+
+// LinkedList is a generic singly linked list
+type LinkedList[T any] struct {
+	head *node[T]
+	tail *node[T] // kept so PushBack is O(1)
+	size int
+}
+
+// PushFront inserts v at the beginning of the list
+func (l *LinkedList[T]) PushFront(v T) {
+	n := &node[T]{value: v, next: l.head}
+	l.head = n
+	if l.tail == nil {
+		l.tail = n
+	}
+	l.size++
+}
+
+// PushBack inserts v at the end of the list
+func (l *LinkedList[T]) PushBack(v T) {
+	n := &node[T]{value: v}
+	if l.tail == nil {
+		l.head = n
+	} else {
+		l.tail.next = n
+	}
+	l.tail = n
+	l.size++
+}
+
+// Remove deletes every element for which predicate returns true
+// and reports how many were removed
+func (l *LinkedList[T]) Remove(predicate func(T) bool) int {
+	removed := 0
+	var prev *node[T]
+	for cur := l.head; cur != nil; cur = cur.next {
+		if !predicate(cur.value) {
+			prev = cur
+			continue
+		}
+		if prev == nil {
+			l.head = cur.next // removing the head
+		} else {
+			prev.next = cur.next // unlink: skip over cur
+		}
+		if cur == l.tail {
+			l.tail = prev // removing the tail
+		}
+		l.size--
+		removed++
+	}
+	return removed
+}
+
+// Len returns the number of elements
+func (l *LinkedList[T]) Len() int {
+	return l.size
+}
+
+// ToSlice returns the elements in order
+func (l *LinkedList[T]) ToSlice() []T {
+	out := make([]T, 0, l.size)
+	for n := l.head; n != nil; n = n.next {
+		out = append(out, n.value)
+	}
+	return out
+}
+
+// RunLinkedList runs the linked list examples
+func RunLinkedList(w io.Writer) {
+	topicBanner("LINKED LISTS IN GO", w)
+
+	heading(w, "BUILDING A LIST")
+	var list LinkedList[int]
+	list.PushBack(20)
+	list.PushBack(30)
+	list.PushFront(10)
+	list.PushBack(40)
+	fmt.Fprintf(w, "PushBack(20), PushBack(30), PushFront(10), PushBack(40): %v (len=%d)\n",
+		list.ToSlice(), list.Len())
+
+	heading(w, "FOLLOWING THE POINTERS")
+	for n := list.head; n != nil; n = n.next {
+		fmt.Fprintf(w, "  node %p: value=%d, next=%p\n", n, n.value, n.next)
+	}
+	fmt.Fprintln(w, "  (next=0x0 is nil: the loop stops there)")
+
+	heading(w, "REMOVING NODES")
+	list.Remove(func(v int) bool { return v == 10 })
+	fmt.Fprintf(w, "Remove head (10): %v\n", list.ToSlice())
+	list.Remove(func(v int) bool { return v == 40 })
+	fmt.Fprintf(w, "Remove tail (40): %v\n", list.ToSlice())
+	list.PushBack(50)
+	fmt.Fprintf(w, "PushBack(50) after tail removal: %v\n", list.ToSlice())
+	list.Remove(func(v int) bool { return v == 30 })
+	fmt.Fprintf(w, "Remove middle (30): %v (len=%d)\n", list.ToSlice(), list.Len())
+}
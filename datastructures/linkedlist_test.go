@@ -0,0 +1,59 @@
+package datastructures
+
+import (
+	"slices"
+	"testing"
+)
+
+func newIntList(vals ...int) *LinkedList[int] {
+	var l LinkedList[int]
+	for _, v := range vals {
+		l.PushBack(v)
+	}
+	return &l
+}
+
+func equals(n int) func(int) bool {
+	return func(v int) bool { return v == n }
+}
+
+func TestLinkedListRemove(t *testing.T) {
+	tests := []struct {
+		name   string
+		remove int
+		want   []int
+	}{
+		{"head", 1, []int{2, 3, 4}},
+		{"middle", 3, []int{1, 2, 4}},
+		{"tail", 4, []int{1, 2, 3}},
+		{"missing", 9, []int{1, 2, 3, 4}},
+	}
+	for _, tt := range tests {
+		l := newIntList(1, 2, 3, 4)
+		l.Remove(equals(tt.remove))
+		if got := l.ToSlice(); !slices.Equal(got, tt.want) || l.Len() != len(tt.want) {
+			t.Errorf("remove %s: got %v (len %d), want %v", tt.name, got, l.Len(), tt.want)
+		}
+
+		// tail must still be right: PushBack after the removal goes last
+		l.PushBack(5)
+		if got, want := l.ToSlice(), append(tt.want, 5); !slices.Equal(got, want) {
+			t.Errorf("remove %s, then PushBack(5): got %v, want %v", tt.name, got, want)
+		}
+	}
+}
+
+func TestLinkedListRemoveAll(t *testing.T) {
+	l := newIntList(7, 1, 7, 7)
+	if n := l.Remove(equals(7)); n != 3 {
+		t.Errorf("Remove(7) removed %d, want 3", n)
+	}
+	l.Remove(equals(1))
+	if l.Len() != 0 || l.head != nil || l.tail != nil {
+		t.Errorf("after removing everything: len=%d head=%v tail=%v, want empty", l.Len(), l.head, l.tail)
+	}
+	l.PushFront(8)
+	if got := l.ToSlice(); !slices.Equal(got, []int{8}) || l.tail != l.head {
+		t.Errorf("PushFront on emptied list: %v, tail == head? %v", got, l.tail == l.head)
+	}
+}
@@ -1,66 +1,278 @@
-package main
+// Package datastructures is an interactive tutorial on arrays, slices,
+// maps, structs, and the data structures built from them. Run it with
+// "go run ./cmd/tutorial datastructures" from the repository root.
+package datastructures
 
 import (
 	"bufio"
+	"errors"
+	"flag"
 	"fmt"
+	"io"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 )
 
-func main() {
-	fmt.Println("╔════════════════════════════════════════════════════════════╗")
-	fmt.Println("║          GO DATA STRUCTURES TUTORIAL                      ║")
-	fmt.Println("║   Arrays, Slices, Maps, Structs, new() and make()         ║")
-	fmt.Println("╚════════════════════════════════════════════════════════════╝")
+// Topic is one entry of the tutorial menu
+type Topic struct {
+	Key    string // name for -topic and quizzes
+	Name   string // label shown in the menu
+	Module string // group the progress summary reports on
+	Run    func(io.Writer)
+}
+
+// registry lists every topic in menu order. Adding a line here is all it
+// takes to get a menu number, a -topic name and a place in RunAll.
+var registry = []Topic{
+	{"arrays", "Arrays & Slices", "Built-in Types", RunArraysSlices},
+	{"maps", "Maps", "Built-in Types", RunMaps},
+	{"structs", "Structs", "Built-in Types", RunStructs},
+	{"newmake", "new() vs make()", "Built-in Types", RunNewVsMake},
+	{"stacks", "Stacks & Queues", "Data Structures", RunStackQueue},
+	{"linkedlist", "Linked Lists", "Data Structures", RunLinkedList},
+	{"trie", "Tries (Prefix Trees)", "Data Structures", RunTrie},
+	{"heap", "Heaps & Priority Queues", "Data Structures", RunHeap},
+	{"ringbuffer", "Ring Buffers", "Data Structures", RunRingBuffer},
+	{"csv", "CSV Import/Export", "Encoding", RunCSV},
+	{"constants", "Constants & iota", "Built-in Types", RunConstants},
+}
+
+// Main runs the tutorial with the given command-line arguments (without
+// the program name) and returns the process exit code: the interactive
+// menu by default, or a single topic with -topic.
+func Main(args []string) int {
+	fs := flag.NewFlagSet("datastructures", flag.ContinueOnError)
+	topic := fs.String("topic", "", "run one topic and exit: "+strings.Join(topicNames(), ", "))
+	color := fs.Bool("color", defaultColor(), "ANSI colors in output (default on for terminals unless NO_COLOR is set)")
+	out := fs.String("out", "", "also write all tutorial output to this `file`")
+	selftest := fs.Bool("selftest", false, "run every topic with output discarded and report any panics")
+	timing := fs.Bool("time", false, "print how long each topic takes")
+	progressFile := fs.String("progress", "", "remember completed topics in this JSON `file`")
+	width := fs.Int("width", defaultWidth, "width of banners and separators, in `columns`")
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+		return 2 // fs has already printed the error and usage
+	}
+	if *width < minBannerWidth {
+		fmt.Fprintf(os.Stderr, "-width must be at least %d, got %d\n\n", minBannerWidth, *width)
+		fs.Usage()
+		return 2
+	}
+	// Every topic writes through p, which carries the output options
+	p := &printer{Writer: os.Stdout, color: *color, timing: *timing, width: *width}
+
+	if *selftest {
+		if !selfTest(p) {
+			return 1
+		}
+		return 0
+	}
+
+	// Resolve -topic before creating the transcript, so a typo leaves
+	// no empty file behind
+	var run func(io.Writer)
+	var covers []string // topic keys that run completes, for -progress
+	switch *topic {
+	case "":
+	case "all":
+		run = RunAll
+		covers = topicKeys()
+	default:
+		t, ok := findTopic(*topic)
+		if !ok {
+			fmt.Fprintf(os.Stderr, "unknown topic %q\n\n", *topic)
+			fs.Usage()
+			return 2
+		}
+		run = func(w io.Writer) { timed(t.Key, w, t.Run) }
+		covers = []string{t.Key}
+	}
+
+	var progress *Progress
+	if *progressFile != "" {
+		var err error
+		if progress, err = LoadProgress(*progressFile); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+	}
+	// track marks topics done; a no-op without -progress
+	track := func(keys ...string) {
+		if progress == nil {
+			return
+		}
+		for _, k := range keys {
+			if err := progress.MarkDone(k); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				return
+			}
+		}
+	}
+
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "transcript: %v\n", err)
+			return 1
+		}
+		defer f.Close()
+		p.Writer = io.MultiWriter(os.Stdout, f) // tee: every write goes to both
+	}
+
+	w := io.Writer(p)
+
+	// Non-interactive mode: handy for scripts and CI smoke tests
+	if run != nil {
+		run(w)
+		track(covers...)
+		if progress != nil {
+			fmt.Fprintln(w)
+			progress.WriteSummary(w)
+		}
+		return 0
+	}
+
+	banner("GO DATA STRUCTURES TUTORIAL\nArrays, Slices, Maps, Structs, new() and make()", p.width, w)
 
 	reader := bufio.NewReader(os.Stdin)
+	quizMode := false
+	runAll := len(registry) + 1 // menu number of "Run ALL"
 
 	for {
-		fmt.Println("\n" + strings.Repeat("─", 60))
-		fmt.Println("Select a topic to learn:")
-		fmt.Println("  1. Arrays & Slices")
-		fmt.Println("  2. Maps")
-		fmt.Println("  3. Structs")
-		fmt.Println("  4. new() vs make()")
-		fmt.Println("  5. Run ALL examples")
-		fmt.Println("  0. Exit")
-		fmt.Print("\nYour choice: ")
-
-		input, _ := reader.ReadString('\n')
+		fmt.Fprintln(w, "\n"+strings.Repeat("─", p.width))
+		fmt.Fprintln(w, "Select a topic to learn:")
+		for i, t := range registry {
+			fmt.Fprintf(w, "  %d. %s\n", i+1, t.Name)
+		}
+		fmt.Fprintf(w, "  %d. Run ALL examples\n", runAll)
+		fmt.Fprintf(w, "  q. Toggle quiz after each topic (now: %s)\n", onOff(quizMode))
+		if progress != nil {
+			fmt.Fprintln(w, "  p. Show my progress")
+		}
+		fmt.Fprintln(w, "  0. Exit")
+		fmt.Fprint(w, "\nYour choice: ")
+
+		input, err := reader.ReadString('\n')
+		if err != nil && input == "" {
+			// stdin closed (Ctrl-D or end of piped input): leave the loop
+			// instead of re-printing the menu forever
+			sayGoodbye(w, err)
+			return 0
+		}
 		input = strings.TrimSpace(input)
 
-		switch input {
-		case "1":
-			RunArraysSlices()
-		case "2":
-			RunMaps()
-		case "3":
-			RunStructs()
-		case "4":
-			RunNewVsMake()
-		case "5":
-			RunAll()
-		case "0":
-			fmt.Println("\nHappy coding! 🚀")
-			return
+		if input == "q" {
+			quizMode = !quizMode
+			fmt.Fprintf(w, "\nQuiz mode is now %s.\n", onOff(quizMode))
+			continue
+		}
+		if input == "p" && progress != nil {
+			heading(w, "YOUR PROGRESS")
+			progress.WriteSummary(w)
+			continue
+		}
+
+		choice, convErr := strconv.Atoi(input)
+		switch {
+		case convErr != nil || choice < 0 || choice > runAll:
+			fmt.Fprintf(w, "\n%s Invalid choice. Please enter 0-%d or q.\n", failMark(w), runAll)
+		case choice == 0:
+			sayGoodbye(w, nil)
+			return 0
+		case choice == runAll:
+			RunAll(w)
+			track(topicKeys()...)
 		default:
-			fmt.Println("\n❌ Invalid choice. Please enter 0-5.")
+			t := registry[choice-1]
+			timed(t.Key, w, t.Run)
+			track(t.Key)
+			if questions, ok := quizzes[t.Key]; ok && quizMode {
+				RunQuiz(questions, reader, w)
+			}
 		}
 
-		fmt.Println("\n" + strings.Repeat("─", 60))
-		fmt.Print("Press ENTER to continue...")
-		reader.ReadString('\n')
+		fmt.Fprintln(w, "\n"+strings.Repeat("─", p.width))
+		fmt.Fprint(w, "Press ENTER to continue...")
+		if _, err := reader.ReadString('\n'); err != nil {
+			sayGoodbye(w, err)
+			return 0
+		}
 	}
 }
 
+// sayGoodbye prints the exit message; err is why input ended, if not "0"
+func sayGoodbye(w io.Writer, err error) {
+	switch {
+	case err == nil:
+	case err == io.EOF:
+		fmt.Fprintln(w, "\n\nNo more input (EOF), exiting.")
+	default:
+		fmt.Fprintf(os.Stderr, "\n\nError reading input: %v\n", err)
+	}
+	fmt.Fprintln(w, "\nHappy coding! 🚀")
+}
+
+// onOff formats a toggle for the menu
+func onOff(b bool) string {
+	if b {
+		return "on"
+	}
+	return "off"
+}
+
 // RunAll executes all examples in sequence
-func RunAll() {
-	RunArraysSlices()
-	RunMaps()
-	RunStructs()
-	RunNewVsMake()
-
-	fmt.Println("\n" + strings.Repeat("=", 60))
-	fmt.Println("ALL EXAMPLES COMPLETED!")
-	fmt.Println(strings.Repeat("=", 60))
+func RunAll(w io.Writer) {
+	start := time.Now()
+	for _, t := range registry {
+		timed(t.Key, w, t.Run)
+	}
+
+	topicBanner("ALL EXAMPLES COMPLETED!", w)
+	if printerOf(w).timing {
+		fmt.Fprintf(w, "[total: %v]\n", roundDuration(time.Since(start)))
+	}
+}
+
+// timed runs f and, with --time, prints how long it took as [name: 2.3ms]
+func timed(name string, w io.Writer, f func(io.Writer)) {
+	if !printerOf(w).timing {
+		f(w)
+		return
+	}
+	start := time.Now()
+	f(w)
+	fmt.Fprintf(w, "\n[%s: %v]\n", name, roundDuration(time.Since(start)))
+}
+
+// roundDuration keeps timings readable: 2.3ms rather than 2.314159ms
+func roundDuration(d time.Duration) time.Duration {
+	return d.Round(100 * time.Microsecond)
+}
+
+// findTopic looks up a registered topic by its -topic name
+func findTopic(key string) (Topic, bool) {
+	for _, t := range registry {
+		if t.Key == key {
+			return t, true
+		}
+	}
+	return Topic{}, false
+}
+
+// topicKeys returns the key of every registered topic, in menu order
+func topicKeys() []string {
+	keys := make([]string, 0, len(registry))
+	for _, t := range registry {
+		keys = append(keys, t.Key)
+	}
+	return keys
+}
+
+// topicNames returns the valid -topic values in menu order
+func topicNames() []string {
+	return append(topicKeys(), "all")
 }
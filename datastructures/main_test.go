@@ -0,0 +1,82 @@
+package datastructures
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// quietStdio points os.Stdin, os.Stdout and os.Stderr at the null device
+// for the rest of the test: Main reads and writes the real ones
+func quietStdio(t *testing.T) {
+	t.Helper()
+	devNull, err := os.OpenFile(os.DevNull, os.O_RDWR, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdin, stdout, stderr := os.Stdin, os.Stdout, os.Stderr
+	t.Cleanup(func() {
+		os.Stdin, os.Stdout, os.Stderr = stdin, stdout, stderr
+		devNull.Close()
+	})
+	os.Stdin, os.Stdout, os.Stderr = devNull, devNull, devNull
+}
+
+func TestMainSmoke(t *testing.T) {
+	quietStdio(t)
+
+	tests := []struct {
+		name string
+		args []string
+		want int
+	}{
+		{"one topic", []string{"-topic", "maps"}, 0},
+		{"every option", []string{"-topic", "structs", "-color", "-time", "-width", "40"}, 0},
+		{"menu with stdin at EOF", nil, 0}, // like: echo | go run ./cmd/tutorial datastructures
+		{"selftest", []string{"-selftest"}, 0},
+		{"help", []string{"-h"}, 0},
+		{"unknown topic", []string{"-topic", "nope"}, 2},
+		{"bad flag", []string{"-nope"}, 2},
+		{"negative width", []string{"-width", "-1"}, 2},
+		{"width below the banner minimum", []string{"-width", "4"}, 2},
+		{"smallest width", []string{"-topic", "constants", "-width", "5"}, 0},
+	}
+	for _, tt := range tests {
+		if got := Main(tt.args); got != tt.want {
+			t.Errorf("%s: Main(%q) = %d, want %d", tt.name, tt.args, got, tt.want)
+		}
+	}
+}
+
+func TestMainTranscriptAndProgress(t *testing.T) {
+	quietStdio(t)
+	dir := t.TempDir()
+	transcript := filepath.Join(dir, "session.txt")
+	progress := filepath.Join(dir, "progress.json")
+
+	if got := Main([]string{"-topic", "all", "-out", transcript, "-progress", progress}); got != 0 {
+		t.Fatalf("Main(-topic all -out ... -progress ...) = %d, want 0", got)
+	}
+	data, err := os.ReadFile(transcript)
+	if err != nil {
+		t.Fatalf("transcript: %v", err)
+	}
+	if !strings.Contains(string(data), "ALL EXAMPLES COMPLETED!") {
+		t.Errorf("transcript is missing the closing banner (%d bytes)", len(data))
+	}
+	p, err := LoadProgress(progress)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(p.Done) != len(registry) {
+		t.Errorf("progress after -topic all = %v, want all %d topics done", p.Done, len(registry))
+	}
+
+	// A typo in -topic must not leave an empty transcript behind
+	typo := filepath.Join(dir, "typo.txt")
+	Main([]string{"-topic", "mapz", "-out", typo})
+	if _, err := os.Stat(typo); !os.IsNotExist(err) {
+		t.Errorf("Main with an unknown topic created %s (err = %v)", typo, err)
+	}
+}
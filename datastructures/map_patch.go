@@ -0,0 +1,58 @@
+package datastructures
+
+import (
+	"fmt"
+	"io"
+	"maps"
+)
+
+// MAP DIFF AND PATCH
+// ==================
+// Syncing state often means sending only what changed.
+// MapPatch splits the difference between two maps into three disjoint
+// buckets; ApplyMapPatch replays them onto the old map.
+
+// MapPatch compares old and current and reports:
+// - added:   keys only in current
+// - removed: keys only in old
+// - changed: keys in both with different values, as [old, current]
+// It is DiffMaps (map_utils.go) under the name this file's patch API
+// uses; keys with equal values appear in none of the buckets.
+func MapPatch[K comparable, V comparable](old, current map[K]V) (added, removed map[K]V, changed map[K][2]V) {
+	return DiffMaps(old, current)
+}
+
+// ApplyMapPatch modifies m in place so that it matches the map the patch
+// was computed against
+func ApplyMapPatch[K comparable, V comparable](m map[K]V, added, removed map[K]V, changed map[K][2]V) {
+	for k := range removed {
+		delete(m, k)
+	}
+	for k, v := range added {
+		m[k] = v
+	}
+	for k, pair := range changed {
+		m[k] = pair[1]
+	}
+}
+
+// MapPatternPatch demonstrates diffing and patching map state
+func MapPatternPatch(w io.Writer) {
+	heading(w, "PATTERN: DIFF AND PATCH")
+
+	server := map[string]int{"apples": 5, "bananas": 3, "cherries": 7}
+	client := map[string]int{"apples": 5, "bananas": 4, "dates": 1}
+
+	added, removed, changed := MapPatch(server, client)
+	fmt.Fprintf(w, "Old (server): %v\n", server)
+	fmt.Fprintf(w, "New (client): %v\n", client)
+	fmt.Fprintf(w, "  added:   %v\n", added)
+	fmt.Fprintf(w, "  removed: %v\n", removed)
+	fmt.Fprintf(w, "  changed: %v (as [old new])\n", changed)
+	fmt.Fprintln(w, "  (\"apples\" is unchanged, so it is in no bucket)")
+
+	// Sending just the patch is enough to bring the server up to date
+	ApplyMapPatch(server, added, removed, changed)
+	fmt.Fprintf(w, "Server after patch: %v, equal to client? %v\n",
+		server, maps.Equal(server, client))
+}
@@ -0,0 +1,57 @@
+package datastructures
+
+import (
+	"maps"
+	"testing"
+)
+
+func TestMapPatchBuckets(t *testing.T) {
+	old := map[string]int{"apples": 5, "bananas": 3, "cherries": 7, "figs": 2}
+	current := map[string]int{"apples": 5, "bananas": 4, "dates": 1, "figs": 9}
+	added, removed, changed := MapPatch(old, current)
+
+	// Every key of either map is classified exactly once: in one bucket,
+	// or in none when its value is unchanged
+	union := maps.Clone(old)
+	maps.Copy(union, current)
+	for k := range union {
+		buckets := 0
+		if _, ok := added[k]; ok {
+			buckets++
+		}
+		if _, ok := removed[k]; ok {
+			buckets++
+		}
+		if _, ok := changed[k]; ok {
+			buckets++
+		}
+		oldV, inOld := old[k]
+		newV, inNew := current[k]
+		unchanged := inOld && inNew && oldV == newV
+		switch {
+		case unchanged && buckets != 0:
+			t.Errorf("unchanged key %q is in %d bucket(s)", k, buckets)
+		case !unchanged && buckets != 1:
+			t.Errorf("key %q is in %d buckets, want exactly 1", k, buckets)
+		}
+	}
+	// ...and the buckets hold nothing else
+	if n := len(added) + len(removed) + len(changed); n != 4 {
+		t.Errorf("buckets hold %d keys, want 4 (bananas, cherries, dates, figs)", n)
+	}
+
+	if want := map[string]int{"dates": 1}; !maps.Equal(added, want) {
+		t.Errorf("added = %v, want %v", added, want)
+	}
+	if want := map[string]int{"cherries": 7}; !maps.Equal(removed, want) {
+		t.Errorf("removed = %v, want %v", removed, want)
+	}
+	if want := map[string][2]int{"bananas": {3, 4}, "figs": {2, 9}}; !maps.Equal(changed, want) {
+		t.Errorf("changed = %v, want %v", changed, want)
+	}
+
+	ApplyMapPatch(old, added, removed, changed)
+	if !maps.Equal(old, current) {
+		t.Errorf("after ApplyMapPatch old = %v, want %v", old, current)
+	}
+}
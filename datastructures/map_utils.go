@@ -0,0 +1,85 @@
+package datastructures
+
+import (
+	"cmp"
+	"slices"
+)
+
+// MAP UTILITIES
+// =============
+// Small generic helpers for composing maps.
+
+// Keys returns the keys of m in unspecified order - it follows map
+// iteration, which differs from run to run. Use SortedKeys for a stable
+// order. (Go 1.23+ has maps.Keys, which returns an iterator instead.)
+func Keys[K comparable, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// Values returns the values of m in unspecified order
+func Values[K comparable, V any](m map[K]V) []V {
+	values := make([]V, 0, len(m))
+	for _, v := range m {
+		values = append(values, v)
+	}
+	return values
+}
+
+// SortedKeys returns the keys of m in ascending order. K must be
+// ordered (numbers, strings), not just comparable.
+func SortedKeys[K cmp.Ordered, V any](m map[K]V) []K {
+	keys := Keys(m)
+	slices.Sort(keys)
+	return keys
+}
+
+// MergeMaps copies every entry of src into dst. On conflicting keys the
+// value from src wins. dst must be non-nil (writing to a nil map panics).
+func MergeMaps[K comparable, V any](dst, src map[K]V) {
+	for k, v := range src {
+		dst[k] = v
+	}
+}
+
+// InvertMap swaps keys and values. If several keys share a value, the
+// last one written wins - and because map iteration order is random,
+// which key that is is unspecified. Invert only maps with unique values
+// if the result must be deterministic.
+func InvertMap[K, V comparable](m map[K]V) map[V]K {
+	inverted := make(map[V]K, len(m))
+	for k, v := range m {
+		inverted[v] = k
+	}
+	return inverted
+}
+
+// DiffMaps compares old and current and reports:
+// - added:   keys only in current
+// - removed: keys only in old
+// - changed: keys in both with different values, as [old, current]
+// Keys with equal values appear in none of the buckets.
+func DiffMaps[K comparable, V comparable](old, current map[K]V) (added, removed map[K]V, changed map[K][2]V) {
+	added = make(map[K]V)
+	removed = make(map[K]V)
+	changed = make(map[K][2]V)
+
+	for k, oldVal := range old {
+		newVal, ok := current[k]
+		switch {
+		case !ok:
+			removed[k] = oldVal
+		case oldVal != newVal:
+			changed[k] = [2]V{oldVal, newVal}
+		}
+	}
+	for k, newVal := range current {
+		if _, ok := old[k]; !ok {
+			added[k] = newVal
+		}
+	}
+	return added, removed, changed
+}
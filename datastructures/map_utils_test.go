@@ -0,0 +1,139 @@
+package datastructures
+
+import (
+	"maps"
+	"slices"
+	"testing"
+)
+
+func TestKeysValues(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3, "d": 2}
+
+	keys := Keys(m)
+	if len(keys) != len(m) {
+		t.Fatalf("Keys: got %d keys, want %d", len(keys), len(m))
+	}
+	for _, k := range keys {
+		if _, ok := m[k]; !ok {
+			t.Errorf("Keys returned %q, which is not in the map", k)
+		}
+	}
+	// Order is unspecified: compare sorted copies
+	slices.Sort(keys)
+	if want := []string{"a", "b", "c", "d"}; !slices.Equal(keys, want) {
+		t.Errorf("Keys sorted = %v, want %v", keys, want)
+	}
+
+	values := Values(m)
+	slices.Sort(values)
+	if want := []int{1, 2, 2, 3}; !slices.Equal(values, want) {
+		t.Errorf("Values sorted = %v, want %v (duplicates kept)", values, want)
+	}
+
+	if got := Keys(map[string]int(nil)); len(got) != 0 {
+		t.Errorf("Keys(nil) = %v, want empty", got)
+	}
+}
+
+func TestSortedKeys(t *testing.T) {
+	m := map[int]string{3: "c", 1: "a", 2: "b"}
+	if got, want := SortedKeys(m), []int{1, 2, 3}; !slices.Equal(got, want) {
+		t.Errorf("SortedKeys = %v, want %v", got, want)
+	}
+}
+
+func TestDiffMaps(t *testing.T) {
+	old := map[string]int{"onlyOld": 1, "changed": 2, "same": 3}
+	current := map[string]int{"onlyNew": 4, "changed": 20, "same": 3}
+	added, removed, changed := DiffMaps(old, current)
+
+	if want := map[string]int{"onlyNew": 4}; !maps.Equal(added, want) {
+		t.Errorf("added = %v, want %v", added, want)
+	}
+	if want := map[string]int{"onlyOld": 1}; !maps.Equal(removed, want) {
+		t.Errorf("removed = %v, want %v", removed, want)
+	}
+	if want := map[string][2]int{"changed": {2, 20}}; !maps.Equal(changed, want) {
+		t.Errorf("changed = %v, want %v", changed, want)
+	}
+	// Unchanged keys are in no bucket
+	_, inAdded := added["same"]
+	_, inRemoved := removed["same"]
+	_, inChanged := changed["same"]
+	if inAdded || inRemoved || inChanged {
+		t.Errorf("unchanged key %q reported: added=%v removed=%v changed=%v", "same", inAdded, inRemoved, inChanged)
+	}
+}
+
+func TestDiffMapsEmpty(t *testing.T) {
+	m := map[string]int{"a": 1}
+	tests := []struct {
+		name                   string
+		old, current           map[string]int
+		nAdded, nRem, nChanged int
+	}{
+		{"both nil", nil, nil, 0, 0, 0},
+		{"all added", nil, m, 1, 0, 0},
+		{"all removed", m, map[string]int{}, 0, 1, 0},
+		{"identical", m, map[string]int{"a": 1}, 0, 0, 0},
+	}
+	for _, tt := range tests {
+		added, removed, changed := DiffMaps(tt.old, tt.current)
+		if len(added) != tt.nAdded || len(removed) != tt.nRem || len(changed) != tt.nChanged {
+			t.Errorf("%s: got %d added, %d removed, %d changed; want %d, %d, %d", tt.name,
+				len(added), len(removed), len(changed), tt.nAdded, tt.nRem, tt.nChanged)
+		}
+		// The buckets are never nil, so callers can write to them
+		if added == nil || removed == nil || changed == nil {
+			t.Errorf("%s: got a nil bucket", tt.name)
+		}
+	}
+}
+
+func TestMergeMaps(t *testing.T) {
+	tests := []struct {
+		name     string
+		dst, src map[string]int
+		want     map[string]int
+	}{
+		{"src wins on conflict", map[string]int{"a": 1, "b": 2}, map[string]int{"b": 20, "c": 3},
+			map[string]int{"a": 1, "b": 20, "c": 3}},
+		{"empty src", map[string]int{"a": 1}, map[string]int{}, map[string]int{"a": 1}},
+		{"nil src", map[string]int{"a": 1}, nil, map[string]int{"a": 1}},
+		{"empty dst", map[string]int{}, map[string]int{"a": 1}, map[string]int{"a": 1}},
+		{"both empty", map[string]int{}, map[string]int{}, map[string]int{}},
+	}
+	for _, tt := range tests {
+		src := maps.Clone(tt.src)
+		MergeMaps(tt.dst, tt.src)
+		if !maps.Equal(tt.dst, tt.want) {
+			t.Errorf("%s: dst = %v, want %v", tt.name, tt.dst, tt.want)
+		}
+		if !maps.Equal(tt.src, src) {
+			t.Errorf("%s: src modified to %v, was %v", tt.name, tt.src, src)
+		}
+	}
+}
+
+func TestInvertMap(t *testing.T) {
+	got := InvertMap(map[string]int{"one": 1, "two": 2})
+	if want := map[int]string{1: "one", 2: "two"}; !maps.Equal(got, want) {
+		t.Errorf("InvertMap(unique values) = %v, want %v", got, want)
+	}
+
+	if got := InvertMap(map[string]int{}); len(got) != 0 {
+		t.Errorf("InvertMap(empty) = %v, want empty", got)
+	}
+
+	// "a" and "b" collide on 1: one of them wins, which one is unspecified
+	got = InvertMap(map[string]int{"a": 1, "b": 1, "c": 2})
+	if len(got) != 2 {
+		t.Fatalf("InvertMap(collision) = %v, want 2 entries", got)
+	}
+	if k := got[1]; k != "a" && k != "b" {
+		t.Errorf("InvertMap(collision)[1] = %q, want \"a\" or \"b\"", k)
+	}
+	if k := got[2]; k != "c" {
+		t.Errorf("InvertMap(collision)[2] = %q, want \"c\"", k)
+	}
+}
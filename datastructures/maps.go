@@ -1,8 +1,11 @@
-package main
+package datastructures
 
 import (
 	"fmt"
-	"strings"
+	"io"
+	"runtime"
+	"sort"
+	"strconv"
 )
 
 // MAPS (Hash Tables)
@@ -14,12 +17,12 @@ import (
 // - Unordered collection
 
 // MapBasics demonstrates fundamental map concepts
-func MapBasics() {
-	fmt.Println("\n=== MAP BASICS ===")
+func MapBasics(w io.Writer) {
+	heading(w, "MAP BASICS")
 
 	// Nil map (cannot add elements to it!)
 	var map1 map[string]int
-	fmt.Printf("Nil map: %v, len=%d, is nil? %v\n",
+	fmt.Fprintf(w, "Nil map: %v, len=%d, is nil? %v\n",
 		map1, len(map1), map1 == nil)
 
 	// Map literal initialization
@@ -28,20 +31,20 @@ func MapBasics() {
 		"banana": 3,
 		"orange": 7,
 	}
-	fmt.Printf("Map literal: %v\n", map2)
+	fmt.Fprintf(w, "Map literal: %v\n", map2)
 
 	// Using make() - PROPER WAY to create empty maps
 	map3 := make(map[string]int)
-	fmt.Printf("Empty map with make(): %v, len=%d\n", map3, len(map3))
+	fmt.Fprintf(w, "Empty map with make(): %v, len=%d\n", map3, len(map3))
 
 	// Can specify initial capacity hint (optimization)
 	map4 := make(map[string]int, 100)
-	fmt.Printf("Map with capacity hint: %v, len=%d\n", map4, len(map4))
+	fmt.Fprintf(w, "Map with capacity hint: %v, len=%d\n", map4, len(map4))
 }
 
 // MapOperations demonstrates common map operations
-func MapOperations() {
-	fmt.Println("\n=== MAP OPERATIONS ===")
+func MapOperations(w io.Writer) {
+	heading(w, "MAP OPERATIONS")
 
 	// Create a map
 	scores := make(map[string]int)
@@ -50,44 +53,58 @@ func MapOperations() {
 	scores["Alice"] = 95
 	scores["Bob"] = 87
 	scores["Charlie"] = 92
-	fmt.Printf("After inserts: %v\n", scores)
+	fmt.Fprintf(w, "After inserts: %v\n", scores)
 
 	// Update existing key
 	scores["Alice"] = 98
-	fmt.Printf("After update: %v\n", scores)
+	fmt.Fprintf(w, "After update: %v\n", scores)
 
 	// READ - access by key
 	aliceScore := scores["Alice"]
-	fmt.Printf("Alice's score: %d\n", aliceScore)
+	fmt.Fprintf(w, "Alice's score: %d\n", aliceScore)
 
 	// Reading non-existent key returns zero value
 	noScore := scores["David"]
-	fmt.Printf("Non-existent key returns: %d (zero value)\n", noScore)
+	fmt.Fprintf(w, "Non-existent key returns: %d (zero value)\n", noScore)
 
 	// CHECK EXISTENCE - the "comma ok" idiom
 	if score, exists := scores["Alice"]; exists {
-		fmt.Printf("Alice exists with score: %d\n", score)
+		fmt.Fprintf(w, "Alice exists with score: %d\n", score)
 	}
 
 	if score, exists := scores["David"]; !exists {
-		fmt.Printf("David doesn't exist, got zero value: %d\n", score)
+		fmt.Fprintf(w, "David doesn't exist, got zero value: %d\n", score)
 	}
 
 	// DELETE - using delete() built-in function
 	delete(scores, "Bob")
-	fmt.Printf("After deleting Bob: %v\n", scores)
+	fmt.Fprintf(w, "After deleting Bob: %v\n", scores)
 
 	// Deleting non-existent key is safe (no-op)
 	delete(scores, "NonExistent")
-	fmt.Printf("After deleting non-existent key: %v\n", scores)
+	fmt.Fprintf(w, "After deleting non-existent key: %v\n", scores)
 
 	// LENGTH
-	fmt.Printf("Number of entries: %d\n", len(scores))
+	fmt.Fprintf(w, "Number of entries: %d\n", len(scores))
+
+	// MERGE - copy entries from one map into another (src wins)
+	updates := map[string]int{"Alice": 100, "Eve": 88}
+	MergeMaps(scores, updates)
+	fmt.Fprintf(w, "After MergeMaps with %v: %v\n", updates, scores)
+	MergeMaps(scores, map[string]int{})
+	fmt.Fprintf(w, "Merging an empty map changes nothing: %v\n", scores)
+
+	// INVERT - swap keys and values
+	codes := map[string]int{"OK": 200, "NotFound": 404}
+	fmt.Fprintf(w, "InvertMap(%v): %v\n", codes, InvertMap(codes))
+	dupes := map[string]int{"Alice": 1, "Bob": 1}
+	fmt.Fprintf(w, "InvertMap(%v): %v (duplicate values: one key wins, unspecified which)\n",
+		dupes, InvertMap(dupes))
 }
 
 // MapIteration demonstrates how to iterate over maps
-func MapIteration() {
-	fmt.Println("\n=== MAP ITERATION ===")
+func MapIteration(w io.Writer) {
+	heading(w, "MAP ITERATION")
 
 	ages := map[string]int{
 		"Alice":   30,
@@ -97,31 +114,41 @@ func MapIteration() {
 	}
 
 	// Iterate over key-value pairs
-	fmt.Println("Iterate over key-value pairs:")
+	fmt.Fprintln(w, "Iterate over key-value pairs:")
 	for name, age := range ages {
-		fmt.Printf("  %s is %d years old\n", name, age)
+		fmt.Fprintf(w, "  %s is %d years old\n", name, age)
 	}
 
 	// Iterate over keys only
-	fmt.Println("\nIterate over keys only:")
+	fmt.Fprintln(w, "\nIterate over keys only:")
 	for name := range ages {
-		fmt.Printf("  %s\n", name)
+		fmt.Fprintf(w, "  %s\n", name)
 	}
 
 	// Note: Map iteration order is RANDOM and not guaranteed
-	fmt.Println("\nIteration order is random (run multiple times):")
+	fmt.Fprintln(w, "\nIteration order is random (run multiple times):")
 	for i := 0; i < 3; i++ {
-		fmt.Printf("  Run %d: ", i+1)
+		fmt.Fprintf(w, "  Run %d: ", i+1)
 		for name := range ages {
-			fmt.Printf("%s ", name)
+			fmt.Fprintf(w, "%s ", name)
 		}
-		fmt.Println()
+		fmt.Fprintln(w)
+	}
+
+	// Keys/Values (map_utils.go) collect into slices - same random order
+	fmt.Fprintf(w, "\nKeys(ages): %v\n", Keys(ages))
+	fmt.Fprintf(w, "Values(ages): %v (pairs line up only within one iteration)\n", Values(ages))
+
+	// For a stable order, sort the keys, then look each one up
+	fmt.Fprintln(w, "Sorted by name (SortedKeys):")
+	for _, name := range SortedKeys(ages) {
+		fmt.Fprintf(w, "  %s is %d years old\n", name, ages[name])
 	}
 }
 
 // MapWithComplexTypes demonstrates maps with various key/value types
-func MapWithComplexTypes() {
-	fmt.Println("\n=== MAPS WITH COMPLEX TYPES ===")
+func MapWithComplexTypes(w io.Writer) {
+	heading(w, "MAPS WITH COMPLEX TYPES")
 
 	// Map with struct values
 	type Person struct {
@@ -133,26 +160,26 @@ func MapWithComplexTypes() {
 		"Alice": {Age: 30, City: "NYC"},
 		"Bob":   {Age: 25, City: "LA"},
 	}
-	fmt.Printf("Map with struct values: %v\n", people)
+	fmt.Fprintf(w, "Map with struct values: %v\n", people)
 
 	// Map with slice values
 	grades := map[string][]int{
 		"Alice": {95, 87, 92},
 		"Bob":   {88, 91, 85},
 	}
-	fmt.Printf("Map with slice values: %v\n", grades)
+	fmt.Fprintf(w, "Map with slice values: %v\n", grades)
 
 	// Map with map values (nested maps)
 	matrix := map[string]map[string]int{
 		"row1": {"col1": 1, "col2": 2},
 		"row2": {"col1": 3, "col2": 4},
 	}
-	fmt.Printf("Nested map: %v\n", matrix)
+	fmt.Fprintf(w, "Nested map: %v\n", matrix)
 
 	// Accessing nested map
 	if row, exists := matrix["row1"]; exists {
 		if val, exists := row["col2"]; exists {
-			fmt.Printf("matrix[row1][col2] = %d\n", val)
+			fmt.Fprintf(w, "matrix[row1][col2] = %d\n", val)
 		}
 	}
 
@@ -162,12 +189,131 @@ func MapWithComplexTypes() {
 		2: "two",
 		3: "three",
 	}
-	fmt.Printf("Map with int keys: %v\n", counts)
+	fmt.Fprintf(w, "Map with int keys: %v\n", counts)
+}
+
+// gridLandmarks labels a few cells of a grid, keyed by position
+func gridLandmarks() map[Point]string {
+	return map[Point]string{
+		{X: 0, Y: 0}: "origin",
+		{X: 3, Y: 4}: "treasure",
+		{X: 5, Y: 1}: "well",
+	}
+}
+
+// MapWithStructKeys demonstrates comparable structs as map keys
+func MapWithStructKeys(w io.Writer) {
+	heading(w, "MAPS WITH STRUCT KEYS")
+
+	// Point (two ints) is comparable, so it can be a key: two Points are
+	// the same key exactly when all their fields are ==
+	grid := gridLandmarks()
+	grid[Point{X: 2, Y: 2}] = "tree"
+
+	// Look up with a literal - no need to keep the original key around
+	if label, ok := grid[Point{X: 3, Y: 4}]; ok {
+		fmt.Fprintf(w, "grid[Point{3, 4}] = %q\n", label)
+	}
+	_, ok := grid[Point{X: 4, Y: 3}]
+	fmt.Fprintf(w, "grid[Point{4, 3}] exists? %v (every field must match)\n", ok)
+
+	// Iteration order is random; sort the keys for stable output
+	keys := make([]Point, 0, len(grid))
+	for p := range grid {
+		keys = append(keys, p)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].Y != keys[j].Y {
+			return keys[i].Y < keys[j].Y
+		}
+		return keys[i].X < keys[j].X
+	})
+	for _, p := range keys {
+		fmt.Fprintf(w, "  %v -> %s\n", p, grid[p])
+	}
+
+	// A struct with a slice field is NOT comparable, so it can't be a key:
+	//
+	//	type Route struct{ Stops []string }
+	//	m := map[Route]int{} // compile error: invalid map key type Route
+	//
+	// PersonWithHobbies (structs.go) has the same problem. Key by a
+	// comparable substitute instead, e.g. strings.Join(stops, ",")
+	fmt.Fprintln(w, "Structs with slice, map, or func fields can't be keys (compile error)")
+}
+
+// haveBirthday increments name's Age with read-modify-write: m[k] is a
+// copy of the struct, so the copy has to be stored back. Reports
+// whether name was found (writing back a missing key would add it).
+func haveBirthday(people map[string]Person, name string) bool {
+	p, ok := people[name]
+	if !ok {
+		return false
+	}
+	p.Age++
+	people[name] = p
+	return true
+}
+
+// haveBirthdayPtr does the same for a map of pointers: m[k] is a copy of
+// the POINTER, and both copies point at the same Person
+func haveBirthdayPtr(people map[string]*Person, name string) bool {
+	p, ok := people[name]
+	if !ok {
+		return false
+	}
+	p.Age++
+	return true
+}
+
+// ageEveryone increments every Age. Assigning to keys that already exist
+// is allowed while ranging over a map.
+func ageEveryone(people map[string]Person) {
+	for name, p := range people {
+		p.Age++
+		people[name] = p
+	}
+}
+
+// MapMutateStructValues demonstrates updating struct values stored in a map
+func MapMutateStructValues(w io.Writer) {
+	heading(w, "MUTATING STRUCT VALUES IN A MAP")
+
+	// Map elements aren't addressable (they move when the map grows), so
+	//   people["Alice"].Age++ // compile error: cannot assign
+	people := map[string]Person{
+		"Alice": {Name: "Alice", Age: 30},
+		"Bob":   {Name: "Bob", Age: 25},
+	}
+
+	// 1. Read, modify, write back
+	haveBirthday(people, "Alice")
+	fmt.Fprintf(w, "1. Read-modify-write: Alice is now %d\n", people["Alice"].Age)
+
+	// 2. Store pointers; the struct lives outside the map and is addressable
+	ptrs := map[string]*Person{
+		"Alice": {Name: "Alice", Age: 30},
+		"Bob":   {Name: "Bob", Age: 25},
+	}
+	ptrs["Bob"].Age++ // compiles: ptrs["Bob"] is a pointer value
+	haveBirthdayPtr(ptrs, "Alice")
+	fmt.Fprintf(w, "2. Map of pointers: Alice is %d, Bob is %d\n", ptrs["Alice"].Age, ptrs["Bob"].Age)
+	fmt.Fprintln(w, "   (trade-off: every value is a separate allocation, and can be nil)")
+
+	// 3. Updating every entry: the range variable is a copy too
+	for _, p := range people {
+		p.Age += 100 // BUG: changes the copy only
+	}
+	fmt.Fprintf(w, "3. Range without write-back: Alice=%d, Bob=%d (unchanged)\n",
+		people["Alice"].Age, people["Bob"].Age)
+	ageEveryone(people)
+	fmt.Fprintf(w, "   ageEveryone (writes back): Alice=%d, Bob=%d\n",
+		people["Alice"].Age, people["Bob"].Age)
 }
 
 // MapPatternGrouping demonstrates grouping pattern
-func MapPatternGrouping() {
-	fmt.Println("\n=== PATTERN: GROUPING ===")
+func MapPatternGrouping(w io.Writer) {
+	heading(w, "PATTERN: GROUPING")
 
 	// Group words by first letter
 	words := []string{"apple", "apricot", "banana", "blueberry", "cherry", "coconut"}
@@ -178,15 +324,15 @@ func MapPatternGrouping() {
 		grouped[firstLetter] = append(grouped[firstLetter], word)
 	}
 
-	fmt.Println("Words grouped by first letter:")
+	fmt.Fprintln(w, "Words grouped by first letter:")
 	for letter, wordList := range grouped {
-		fmt.Printf("  %c: %v\n", letter, wordList)
+		fmt.Fprintf(w, "  %c: %v\n", letter, wordList)
 	}
 }
 
 // MapPatternCounting demonstrates counting pattern
-func MapPatternCounting() {
-	fmt.Println("\n=== PATTERN: COUNTING ===")
+func MapPatternCounting(w io.Writer) {
+	heading(w, "PATTERN: COUNTING")
 
 	// Count word occurrences
 	text := []string{"apple", "banana", "apple", "cherry", "banana", "apple"}
@@ -196,15 +342,20 @@ func MapPatternCounting() {
 		counts[word]++
 	}
 
-	fmt.Println("Word counts:")
+	fmt.Fprintln(w, "Word counts:")
 	for word, count := range counts {
-		fmt.Printf("  %s: %d\n", word, count)
+		fmt.Fprintf(w, "  %s: %d\n", word, count)
 	}
+
+	// The map's order is random; WordCounts returns sorted pairs instead
+	pairs := WordCounts(text)
+	fmt.Fprintf(w, "WordCounts (a []Pair[string, int]): %v\n", pairs)
+	fmt.Fprintf(w, "Most common, swapped to Pair[int, string]: %v\n", pairs[0].Swap())
 }
 
 // MapPatternSet demonstrates set implementation using maps
-func MapPatternSet() {
-	fmt.Println("\n=== PATTERN: SET (Using Maps) ===")
+func MapPatternSet(w io.Writer) {
+	heading(w, "PATTERN: SET (Using Maps)")
 
 	// Go doesn't have a built-in set type
 	// Use map[T]bool or map[T]struct{} for sets
@@ -215,23 +366,33 @@ func MapPatternSet() {
 	set1["banana"] = true
 	set1["apple"] = true // Duplicate, no effect
 
-	fmt.Printf("Set (using map[string]bool): %v\n", set1)
+	fmt.Fprintf(w, "Set (using map[string]bool): %v\n", set1)
 
 	// Check membership
 	if set1["apple"] {
-		fmt.Println("  'apple' is in the set")
+		fmt.Fprintln(w, "  'apple' is in the set")
 	}
 
-	// Using map[string]struct{} (more memory efficient)
+	// Using map[string]struct{} (value takes no space, and says "presence only")
 	set2 := make(map[string]struct{})
 	set2["apple"] = struct{}{}
 	set2["banana"] = struct{}{}
 
-	fmt.Println("\nSet (using map[string]struct{}):")
+	fmt.Fprintln(w, "\nSet (using map[string]struct{}):")
 	for item := range set2 {
-		fmt.Printf("  - %s\n", item)
+		fmt.Fprintf(w, "  - %s\n", item)
 	}
 
+	// Measure instead of assuming: struct{} is 0 bytes and bool is 1, but
+	// the map lays out key+value slots with alignment padding
+	boolBytes, structBytes := SetMemoryComparison(100_000)
+	fmt.Fprintf(w, "Heap per element, 100k keys: bool=%d bytes, struct{}=%d bytes\n",
+		boolBytes, structBytes)
+	fmt.Fprintln(w, "  Since Go 1.24 these usually match: a string+bool slot pads to 24")
+	fmt.Fprintln(w, "  bytes, and so does string+struct{} (a trailing zero-size field is")
+	fmt.Fprintln(w, "  padded too). Prefer struct{} for clarity: there's no true/false to misread.")
+	fmt.Fprintln(w, "  (go test -bench=Set -benchmem ./datastructures to check your Go version)")
+
 	// Set operations
 	setA := map[string]bool{"a": true, "b": true, "c": true}
 	setB := map[string]bool{"b": true, "c": true, "d": true}
@@ -244,7 +405,7 @@ func MapPatternSet() {
 	for k := range setB {
 		union[k] = true
 	}
-	fmt.Printf("\nUnion of {a,b,c} and {b,c,d}: %v\n", union)
+	fmt.Fprintf(w, "\nUnion of {a,b,c} and {b,c,d}: %v\n", union)
 
 	// Intersection
 	intersection := make(map[string]bool)
@@ -253,12 +414,62 @@ func MapPatternSet() {
 			intersection[k] = true
 		}
 	}
-	fmt.Printf("Intersection: %v\n", intersection)
+	fmt.Fprintf(w, "Intersection: %v\n", intersection)
+}
+
+// SetMemoryComparison estimates the heap bytes per element of an n-key
+// map[string]bool and map[string]struct{} set. The key strings are built
+// beforehand, so only the maps themselves are measured. For n <= 0 there
+// is nothing to measure and both results are 0.
+func SetMemoryComparison(n int) (boolBytes, structBytes uintptr) {
+	if n <= 0 {
+		return 0, 0
+	}
+	keys := make([]string, n)
+	for i := range keys {
+		keys[i] = "key-" + strconv.Itoa(i)
+	}
+
+	boolBytes = heapPerElement(n, func() any {
+		set := make(map[string]bool)
+		for _, k := range keys {
+			set[k] = true
+		}
+		return set
+	})
+	structBytes = heapPerElement(n, func() any {
+		set := make(map[string]struct{})
+		for _, k := range keys {
+			set[k] = struct{}{}
+		}
+		return set
+	})
+	runtime.KeepAlive(keys) // freeing keys mid-measurement would skew it
+	return boolBytes, structBytes
+}
+
+// heapPerElement reports how much the heap grew while build ran,
+// divided by n (0 if n <= 0)
+func heapPerElement(n int, build func() any) uintptr {
+	if n <= 0 {
+		return 0
+	}
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+	v := build()
+	runtime.GC() // count only what is still reachable
+	runtime.ReadMemStats(&after)
+	runtime.KeepAlive(v)
+	if after.HeapAlloc < before.HeapAlloc {
+		return 0 // something else was freed meanwhile; no estimate
+	}
+	return uintptr(after.HeapAlloc-before.HeapAlloc) / uintptr(n)
 }
 
 // MapPatternCache demonstrates caching pattern
-func MapPatternCache() {
-	fmt.Println("\n=== PATTERN: CACHING/MEMOIZATION ===")
+func MapPatternCache(w io.Writer) {
+	heading(w, "PATTERN: CACHING/MEMOIZATION")
 
 	// Cache expensive computation results
 	cache := make(map[int]int)
@@ -268,7 +479,7 @@ func MapPatternCache() {
 	fib = func(n int) int {
 		// Check cache first
 		if result, exists := cache[n]; exists {
-			fmt.Printf("  Cache hit for fib(%d)\n", n)
+			fmt.Fprintf(w, "  Cache hit for fib(%d)\n", n)
 			return result
 		}
 
@@ -283,82 +494,111 @@ func MapPatternCache() {
 		return result
 	}
 
-	fmt.Println("Computing fib(10) with caching:")
+	fmt.Fprintln(w, "Computing fib(10) with caching:")
 	result := fib(10)
-	fmt.Printf("Result: %d\n", result)
-	fmt.Printf("Cache contents: %v\n", cache)
+	fmt.Fprintf(w, "Result: %d\n", result)
+	fmt.Fprintf(w, "Cache contents: %v\n", cache)
+}
+
+// writeNilMap performs a write to a nil map and turns the resulting
+// panic into an error, so the demo can show it without crashing
+func writeNilMap() (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("recovered: %v", r)
+		}
+	}()
+	var m map[string]int
+	m["key"] = 1 // panics: the nil map has no storage to write into
+	return nil
+}
+
+// demoNilMapPanic shows that a nil map reads like an empty map but
+// panics on write
+func demoNilMapPanic(w io.Writer) {
+	var m map[string]int
+	v, ok := m["missing"]
+	fmt.Fprintf(w, "  Read nil map: m[\"missing\"] = %d, ok=%v, len=%d (safe)\n", v, ok, len(m))
+	delete(m, "missing") // also safe: a no-op, as is ranging over m
+
+	if err := writeNilMap(); err != nil {
+		fmt.Fprintf(w, "  Write nil map: %v\n", err)
+	}
 }
 
 // MapGotchas demonstrates common pitfalls
-func MapGotchas() {
-	fmt.Println("\n=== COMMON GOTCHAS ===")
+func MapGotchas(w io.Writer) {
+	heading(w, "COMMON GOTCHAS")
 
 	// Gotcha 1: Nil map panic
-	fmt.Println("\nGotcha 1: Cannot assign to nil map")
+	fmt.Fprintln(w, "\nGotcha 1: Cannot assign to nil map")
 	var nilMap map[string]int
-	fmt.Printf("nilMap is nil: %v\n", nilMap == nil)
-	// nilMap["key"] = 1 // This would panic!
-	fmt.Println("  (Attempting to assign would cause panic)")
+	fmt.Fprintf(w, "nilMap is nil: %v\n", nilMap == nil)
+	demoNilMapPanic(w)
 
 	// Solution: Initialize with make()
 	nilMap = make(map[string]int)
 	nilMap["key"] = 1
-	fmt.Printf("After make(): %v\n", nilMap)
+	fmt.Fprintf(w, "After make(): %v\n", nilMap)
 
 	// Gotcha 2: Maps are not safe for concurrent access
-	fmt.Println("\nGotcha 2: Maps are not concurrent-safe")
-	fmt.Println("  (Need sync.Mutex or sync.Map for concurrent access)")
+	fmt.Fprintln(w, "\nGotcha 2: Maps are not concurrent-safe")
+	fmt.Fprintln(w, "  (Need sync.Mutex or sync.Map for concurrent access)")
 
 	// Gotcha 3: Can't take address of map element
-	fmt.Println("\nGotcha 3: Cannot take address of map elements")
+	fmt.Fprintln(w, "\nGotcha 3: Cannot take address of map elements")
 	type Point struct{ X, Y int }
 	points := map[string]Point{"origin": {0, 0}}
 
 	// points["origin"].X = 10 // Compilation error!
 	// &points["origin"] // Compilation error!
 
-	// Solution: Read, modify, write back
+	// Solution: Read, modify, write back (see MapMutateStructValues)
 	p := points["origin"]
 	p.X = 10
 	points["origin"] = p
-	fmt.Printf("Modified point: %v\n", points)
+	fmt.Fprintf(w, "Modified point: %v\n", points)
 
 	// Or use pointers as values
 	pointPtrs := map[string]*Point{"origin": {0, 0}}
 	pointPtrs["origin"].X = 10 // This works!
-	fmt.Printf("With pointer values: %v\n", pointPtrs)
+	fmt.Fprintf(w, "With pointer values: %v\n", pointPtrs)
 
 	// Gotcha 4: Checking existence
-	fmt.Println("\nGotcha 4: Zero values vs non-existent keys")
+	fmt.Fprintln(w, "\nGotcha 4: Zero values vs non-existent keys")
 	scores := map[string]int{"Alice": 0}
 
 	aliceScore := scores["Alice"] // 0 (exists, value is 0)
 	bobScore := scores["Bob"]     // 0 (doesn't exist, zero value)
 
-	fmt.Printf("Alice: %d, Bob: %d (both are 0!)\n", aliceScore, bobScore)
+	fmt.Fprintf(w, "Alice: %d, Bob: %d (both are 0!)\n", aliceScore, bobScore)
 
 	// Must use comma-ok idiom to distinguish
 	if _, exists := scores["Alice"]; exists {
-		fmt.Println("  Alice exists")
+		fmt.Fprintln(w, "  Alice exists")
 	}
 	if _, exists := scores["Bob"]; !exists {
-		fmt.Println("  Bob doesn't exist")
+		fmt.Fprintln(w, "  Bob doesn't exist")
 	}
 }
 
 // RunMaps runs all map examples
-func RunMaps() {
-	fmt.Println("\n" + strings.Repeat("=", 60))
-	fmt.Println("MAPS IN GO")
-	fmt.Println(strings.Repeat("=", 60))
-
-	MapBasics()
-	MapOperations()
-	MapIteration()
-	MapWithComplexTypes()
-	MapPatternGrouping()
-	MapPatternCounting()
-	MapPatternSet()
-	MapPatternCache()
-	MapGotchas()
+func RunMaps(w io.Writer) {
+	topicBanner("MAPS IN GO", w)
+
+	MapBasics(w)
+	MapOperations(w)
+	MapIteration(w)
+	MapPatternOrdered(w)
+	MapWithComplexTypes(w)
+	MapWithStructKeys(w)
+	MapMutateStructValues(w)
+	MapPatternGrouping(w)
+	MapPatternCounting(w)
+	MapPatternSet(w)
+	MapPatternCache(w)
+	MapPatternMemoize(w)
+	MapPatternPatch(w)
+	MapPatternSettingsStore(w)
+	MapGotchas(w)
 }
@@ -0,0 +1,224 @@
+package datastructures
+
+import (
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// Map key benchmarks: the same logical workload keyed three ways.
+//
+//	go test -bench=MapKey -benchmem ./datastructures
+//
+// int keys hash fastest; string keys pay for hashing bytes (and building
+// the strings); small struct keys sit in between.
+
+// structKey is a small comparable struct usable as a map key
+type structKey struct {
+	Hi, Lo int32
+}
+
+// mapWorkload is a deterministic list of key ids and values, so every
+// key type performs exactly the same inserts and lookups
+type mapWorkload struct {
+	ids    []int
+	values []int
+}
+
+func newMapWorkload(n int) mapWorkload {
+	rng := rand.New(rand.NewSource(42)) // fixed seed: identical every run
+	w := mapWorkload{ids: make([]int, n), values: make([]int, n)}
+	for i := range w.ids {
+		w.ids[i] = rng.Intn(n / 2) // ~n/2 distinct keys, so some overwrite
+		w.values[i] = rng.Intn(1000)
+	}
+	return w
+}
+
+func (w mapWorkload) stringKeys() []string {
+	keys := make([]string, len(w.ids))
+	for i, id := range w.ids {
+		keys[i] = "key-" + strconv.Itoa(id)
+	}
+	return keys
+}
+
+func (w mapWorkload) structKeys() []structKey {
+	keys := make([]structKey, len(w.ids))
+	for i, id := range w.ids {
+		keys[i] = structKey{Hi: int32(id >> 16), Lo: int32(id & 0xFFFF)}
+	}
+	return keys
+}
+
+// runMapWorkload inserts every (key, value) pair, then sums a lookup of
+// every key. Returns (distinct keys, sum) as the aggregate result.
+func runMapWorkload[K comparable](keys []K, values []int) (int, int) {
+	m := make(map[K]int)
+	for i, k := range keys {
+		m[k] = values[i]
+	}
+	sum := 0
+	for _, k := range keys {
+		sum += m[k]
+	}
+	return len(m), sum
+}
+
+func TestMapKeyTypesAgree(t *testing.T) {
+	w := newMapWorkload(10_000)
+
+	wantLen, wantSum := runMapWorkload(w.ids, w.values)
+	if gotLen, gotSum := runMapWorkload(w.stringKeys(), w.values); gotLen != wantLen || gotSum != wantSum {
+		t.Errorf("string keys: got (%d, %d), int keys got (%d, %d)", gotLen, gotSum, wantLen, wantSum)
+	}
+	if gotLen, gotSum := runMapWorkload(w.structKeys(), w.values); gotLen != wantLen || gotSum != wantSum {
+		t.Errorf("struct keys: got (%d, %d), int keys got (%d, %d)", gotLen, gotSum, wantLen, wantSum)
+	}
+}
+
+const benchMapSize = 10_000
+
+func BenchmarkMapKeyInt(b *testing.B) {
+	w := newMapWorkload(benchMapSize)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		runMapWorkload(w.ids, w.values)
+	}
+}
+
+func BenchmarkMapKeyString(b *testing.B) {
+	w := newMapWorkload(benchMapSize)
+	keys := w.stringKeys() // built once, outside the timed loop
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		runMapWorkload(keys, w.values)
+	}
+}
+
+func BenchmarkMapKeyStruct(b *testing.B) {
+	w := newMapWorkload(benchMapSize)
+	keys := w.structKeys()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		runMapWorkload(keys, w.values)
+	}
+}
+
+// Set benchmarks: map[string]bool vs map[string]struct{} holding the
+// same keys.
+//
+//	go test -bench=Set -benchmem ./datastructures
+//
+// Typical result on Go 1.24+ (Swiss table maps): identical B/op and
+// allocs/op, and times within noise. Each slot is padded to 24 bytes
+// either way, because a trailing zero-size field still gets padding.
+// Older Go versions stored values in a separate array, where struct{}
+// saved one byte per entry (plus padding) over bool.
+
+func setKeys(n int) []string {
+	keys := make([]string, n)
+	for i := range keys {
+		keys[i] = "key-" + strconv.Itoa(i)
+	}
+	return keys
+}
+
+func BenchmarkSetBool(b *testing.B) {
+	keys := setKeys(benchMapSize)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		set := make(map[string]bool)
+		for _, k := range keys {
+			set[k] = true
+		}
+	}
+}
+
+func BenchmarkSetStruct(b *testing.B) {
+	keys := setKeys(benchMapSize)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		set := make(map[string]struct{})
+		for _, k := range keys {
+			set[k] = struct{}{}
+		}
+	}
+}
+
+func TestStructKeys(t *testing.T) {
+	grid := gridLandmarks()
+	grid[Point{X: 2, Y: 2}] = "tree"
+
+	// A fresh literal with equal fields finds the same entry
+	if got, ok := grid[Point{X: 2, Y: 2}]; !ok || got != "tree" {
+		t.Errorf("grid[Point{2, 2}] = %q, %v; want \"tree\", true", got, ok)
+	}
+	if got := grid[Point{X: 3, Y: 4}]; got != "treasure" {
+		t.Errorf("grid[Point{3, 4}] = %q, want \"treasure\"", got)
+	}
+	if _, ok := grid[Point{X: 4, Y: 3}]; ok {
+		t.Error("Point{4, 3} should not match Point{3, 4}")
+	}
+
+	// Re-inserting an equal key overwrites instead of adding
+	grid[Point{X: 2, Y: 2}] = "oak"
+	if len(grid) != 4 || grid[Point{X: 2, Y: 2}] != "oak" {
+		t.Errorf("after overwrite: len=%d, value=%q; want 4, \"oak\"", len(grid), grid[Point{X: 2, Y: 2}])
+	}
+}
+
+func TestMutateStructValues(t *testing.T) {
+	people := map[string]Person{"Alice": {Name: "Alice", Age: 30}}
+	if !haveBirthday(people, "Alice") || people["Alice"].Age != 31 {
+		t.Errorf("haveBirthday: Alice is %d, want 31", people["Alice"].Age)
+	}
+	if haveBirthday(people, "Zed") {
+		t.Error("haveBirthday reported a missing key as found")
+	}
+	if _, ok := people["Zed"]; ok {
+		t.Error("haveBirthday added a missing key")
+	}
+
+	alice := &Person{Name: "Alice", Age: 30}
+	ptrs := map[string]*Person{"Alice": alice}
+	if !haveBirthdayPtr(ptrs, "Alice") || alice.Age != 31 {
+		t.Errorf("haveBirthdayPtr: Alice is %d, want 31", alice.Age)
+	}
+	if haveBirthdayPtr(ptrs, "Zed") {
+		t.Error("haveBirthdayPtr reported a missing key as found")
+	}
+
+	people["Bob"] = Person{Name: "Bob", Age: 25}
+	ageEveryone(people)
+	if people["Alice"].Age != 32 || people["Bob"].Age != 26 || len(people) != 2 {
+		t.Errorf("ageEveryone: got %v", people)
+	}
+}
+
+func TestWriteNilMapRecovers(t *testing.T) {
+	err := writeNilMap()
+	if err == nil {
+		t.Fatal("writeNilMap returned nil; the panic was not recovered into an error")
+	}
+	if !strings.Contains(err.Error(), "assignment to entry in nil map") {
+		t.Errorf("err = %q, want the runtime's nil map message", err)
+	}
+}
+
+func TestSetMemoryComparisonNonPositive(t *testing.T) {
+	for _, n := range []int{0, -1} {
+		if b, s := SetMemoryComparison(n); b != 0 || s != 0 {
+			t.Errorf("SetMemoryComparison(%d) = %d, %d, want 0, 0", n, b, s)
+		}
+	}
+	if got := heapPerElement(0, func() any { return nil }); got != 0 {
+		t.Errorf("heapPerElement(0, ...) = %d, want 0", got)
+	}
+}
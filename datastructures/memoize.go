@@ -0,0 +1,100 @@
+package datastructures
+
+import (
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// GENERIC MEMOIZATION
+// ===================
+// MapPatternCache writes the cache by hand for one function. Memoize
+// wraps ANY single-argument function with a map-backed cache:
+// - K must be comparable, because it becomes a map key
+// - Memoize is NOT safe for concurrent use: two goroutines calling the
+//   wrapper would write the map at once, and the runtime aborts with
+//   "concurrent map writes"
+// - MemoizeSafe adds a mutex, and calls f only once per key even when
+//   several goroutines ask for the same key at the same time
+
+// Memoize returns a function that calls f once per distinct key and
+// serves repeated keys from a cache. Not safe for concurrent use.
+func Memoize[K comparable, V any](f func(K) V) func(K) V {
+	cache := make(map[K]V)
+	return func(k K) V {
+		if v, ok := cache[k]; ok {
+			return v
+		}
+		v := f(k)
+		cache[k] = v
+		return v
+	}
+}
+
+// memoEntry holds one key's result; once makes sure it's computed once
+type memoEntry[V any] struct {
+	once sync.Once
+	v    V
+}
+
+// MemoizeSafe is Memoize for concurrent callers. The mutex only guards
+// the map lookup, so f runs outside the lock: a slow f for one key
+// doesn't block callers asking for other keys.
+func MemoizeSafe[K comparable, V any](f func(K) V) func(K) V {
+	var mu sync.Mutex
+	cache := make(map[K]*memoEntry[V])
+	return func(k K) V {
+		mu.Lock()
+		e, ok := cache[k]
+		if !ok {
+			e = &memoEntry[V]{}
+			cache[k] = e
+		}
+		mu.Unlock()
+
+		e.once.Do(func() { e.v = f(k) })
+		return e.v
+	}
+}
+
+// MapPatternMemoize demonstrates wrapping a slow function with Memoize
+func MapPatternMemoize(w io.Writer) {
+	heading(w, "PATTERN: GENERIC MEMOIZE")
+
+	calls := 0
+	slowSquare := func(n int) int {
+		calls++
+		time.Sleep(5 * time.Millisecond) // stand-in for real work
+		return n * n
+	}
+
+	square := Memoize(slowSquare)
+	inputs := []int{4, 7, 4, 4, 7, 9}
+	start := time.Now()
+	for _, n := range inputs {
+		square(n)
+	}
+	fmt.Fprintf(w, "%d calls with inputs %v: slowSquare ran %d times (%d cache hits), ~%v\n",
+		len(inputs), inputs, calls, len(inputs)-calls, time.Since(start).Round(5*time.Millisecond))
+
+	// Shared between goroutines: only MemoizeSafe is allowed here
+	var mu sync.Mutex
+	safeCalls := 0
+	safeSquare := MemoizeSafe(func(n int) int {
+		mu.Lock()
+		safeCalls++
+		mu.Unlock()
+		return n * n
+	})
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			safeSquare(i % 3)
+		}()
+	}
+	wg.Wait()
+	fmt.Fprintf(w, "MemoizeSafe: 20 goroutines, 3 distinct keys -> %d calls\n", safeCalls)
+}
@@ -0,0 +1,52 @@
+package datastructures
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestMemoizeCallsOncePerKey(t *testing.T) {
+	calls := map[string]int{}
+	length := Memoize(func(s string) int {
+		calls[s]++
+		return len(s)
+	})
+
+	for _, s := range []string{"go", "gopher", "go", "", "gopher", "go", ""} {
+		if got := length(s); got != len(s) {
+			t.Errorf("length(%q) = %d, want %d", s, got, len(s))
+		}
+	}
+	for k, n := range calls {
+		if n != 1 {
+			t.Errorf("f(%q) called %d times, want 1", k, n)
+		}
+	}
+	if len(calls) != 3 {
+		t.Errorf("f called for %d distinct keys, want 3", len(calls))
+	}
+}
+
+func TestMemoizeSafeConcurrent(t *testing.T) {
+	var calls atomic.Int32
+	square := MemoizeSafe(func(n int) int {
+		calls.Add(1)
+		return n * n
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if got, want := square(i%5), (i%5)*(i%5); got != want {
+				t.Errorf("square(%d) = %d, want %d", i%5, got, want)
+			}
+		}()
+	}
+	wg.Wait()
+	if n := calls.Load(); n != 5 {
+		t.Errorf("f called %d times for 5 distinct keys, want 5", n)
+	}
+}
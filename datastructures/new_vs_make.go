@@ -1,8 +1,8 @@
-package main
+package datastructures
 
 import (
 	"fmt"
-	"strings"
+	"io"
 )
 
 // NEW vs MAKE
@@ -15,23 +15,22 @@ import (
 // - make(T): Initializes and returns T (not pointer), only for slices, maps, channels
 
 // NewBasics demonstrates new() function
-func NewBasics() {
-	fmt.Println("\n=== new() FUNCTION ===")
+func NewBasics(w io.Writer) {
+	heading(w, "new() FUNCTION")
 
 	// new() allocates memory and returns a pointer
 	// The memory is zeroed (set to zero value of the type)
 
 	// new() with basic types
 	intPtr := new(int)
-	fmt.Printf("new(int): %v, value: %d, type: %T\n", intPtr, *intPtr, intPtr)
+	fmt.Fprintf(w, "new(int): %v, value: %d, type: %T\n", intPtr, *intPtr, intPtr)
 
 	*intPtr = 42
-	fmt.Printf("After assignment: %d\n", *intPtr)
-
+	fmt.Fprintf(w, "After assignment: %d\n", *intPtr)
 
 	// new() with strings
 	strPtr := new(string)
-	fmt.Printf("new(string): %v, value: %q, type: %T\n", strPtr, *strPtr, strPtr)
+	fmt.Fprintf(w, "new(string): %v, value: %q, type: %T\n", strPtr, *strPtr, strPtr)
 
 	// new() with structs
 	type Person struct {
@@ -40,187 +39,216 @@ func NewBasics() {
 	}
 
 	personPtr := new(Person)
-	fmt.Printf("new(Person): %v, value: %+v, type: %T\n", personPtr, *personPtr, personPtr)
+	fmt.Fprintf(w, "new(Person): %v, value: %+v, type: %T\n", personPtr, *personPtr, personPtr)
 
 	// Can access fields directly (automatic dereferencing) (can't access through pointer)
 	personPtr.Name = "Alice"
 	personPtr.Age = 30
-	fmt.Printf("After setting fields: %+v\n", *personPtr)
+	fmt.Fprintf(w, "After setting fields: %+v\n", *personPtr)
 
 	// new() with slices - creates pointer to nil slice
 	slicePtr := new([]int)
-	fmt.Printf("new([]int): %v, value: %v, is nil? %v\n",
+	fmt.Fprintf(w, "new([]int): %v, value: %v, is nil? %v\n",
 		slicePtr, *slicePtr, *slicePtr == nil)
 }
 
 // MakeBasics demonstrates make() function
-func MakeBasics() {
-	fmt.Println("\n=== make() FUNCTION ===")
+func MakeBasics(w io.Writer) {
+	heading(w, "make() FUNCTION")
 
 	// make() ONLY works with slices, maps, and channels
 	// It initializes and returns the type itself (not a pointer)
 
 	// make() with slices
-	fmt.Println("\nSlices:")
+	fmt.Fprintln(w, "\nSlices:")
 	slice1 := make([]int, 5)     // length 5, capacity 5
 	slice2 := make([]int, 3, 10) // length 3, capacity 10
 
-	fmt.Printf("make([]int, 5): %v, len=%d, cap=%d, type: %T\n",
+	fmt.Fprintf(w, "make([]int, 5): %v, len=%d, cap=%d, type: %T\n",
 		slice1, len(slice1), cap(slice1), slice1)
-	fmt.Printf("make([]int, 3, 10): %v, len=%d, cap=%d\n",
+	fmt.Fprintf(w, "make([]int, 3, 10): %v, len=%d, cap=%d\n",
 		slice2, len(slice2), cap(slice2))
 
 	// make() with maps
-	fmt.Println("\nMaps:")
+	fmt.Fprintln(w, "\nMaps:")
 	map1 := make(map[string]int)
-	fmt.Printf("make(map[string]int): %v, len=%d, type: %T\n",
+	fmt.Fprintf(w, "make(map[string]int): %v, len=%d, type: %T\n",
 		map1, len(map1), map1)
 
 	map2 := make(map[string]int, 100) // with capacity hint
-	fmt.Printf("make(map[string]int, 100): %v, len=%d\n",
+	fmt.Fprintf(w, "make(map[string]int, 100): %v, len=%d\n",
 		map2, len(map2))
 
 	// Can immediately use maps created with make()
 	map1["key"] = 42
-	fmt.Printf("After insertion: %v\n", map1)
+	fmt.Fprintf(w, "After insertion: %v\n", map1)
 }
 
 // NewVsMakeComparison directly compares new() and make()
-func NewVsMakeComparison() {
-	fmt.Println("\n=== new() vs make() COMPARISON ===")
+func NewVsMakeComparison(w io.Writer) {
+	heading(w, "new() vs make() COMPARISON")
 
-	fmt.Println("\n1. WITH SLICES:")
+	fmt.Fprintln(w, "\n1. WITH SLICES:")
 
 	// Using new() with slice - creates pointer to nil slice
 	sliceNew := new([]int)
-	fmt.Printf("new([]int):\n")
-	fmt.Printf("  Type: %T (pointer to slice)\n", sliceNew)
-	fmt.Printf("  Value: %v (pointer to nil slice)\n", sliceNew)
-	fmt.Printf("  Dereferenced: %v, is nil? %v\n", *sliceNew, *sliceNew == nil)
+	fmt.Fprintf(w, "new([]int):\n")
+	fmt.Fprintf(w, "  Type: %T (pointer to slice)\n", sliceNew)
+	fmt.Fprintf(w, "  Value: %v (pointer to nil slice)\n", sliceNew)
+	fmt.Fprintf(w, "  Dereferenced: %v, is nil? %v\n", *sliceNew, *sliceNew == nil)
 	// Can't append to nil slice without dereferencing
 	*sliceNew = append(*sliceNew, 1, 2, 3)
-	fmt.Printf("  After append: %v\n", *sliceNew)
+	fmt.Fprintf(w, "  After append: %v\n", *sliceNew)
 
 	// Using make() with slice - creates ready-to-use slice
 	sliceMake := make([]int, 3, 5)
-	fmt.Printf("\nmake([]int, 3, 5):\n")
-	fmt.Printf("  Type: %T (slice, not pointer)\n", sliceMake)
-	fmt.Printf("  Value: %v\n", sliceMake)
-	fmt.Printf("  Length: %d, Capacity: %d\n", len(sliceMake), cap(sliceMake))
+	fmt.Fprintf(w, "\nmake([]int, 3, 5):\n")
+	fmt.Fprintf(w, "  Type: %T (slice, not pointer)\n", sliceMake)
+	fmt.Fprintf(w, "  Value: %v\n", sliceMake)
+	fmt.Fprintf(w, "  Length: %d, Capacity: %d\n", len(sliceMake), cap(sliceMake))
 	// Can use immediately
 	sliceMake[0] = 10
 	sliceMake = append(sliceMake, 20)
-	fmt.Printf("  After operations: %v\n", sliceMake)
+	fmt.Fprintf(w, "  After operations: %v\n", sliceMake)
 
-	fmt.Println("\n2. WITH MAPS:")
+	fmt.Fprintln(w, "\n2. WITH MAPS:")
 
 	// Using new() with map - creates pointer to nil map
 	mapNew := new(map[string]int)
-	fmt.Printf("new(map[string]int):\n")
-	fmt.Printf("  Type: %T (pointer to map)\n", mapNew)
-	fmt.Printf("  Value: %v (pointer to nil map)\n", mapNew)
-	fmt.Printf("  Dereferenced: %v, is nil? %v\n", *mapNew, *mapNew == nil)
+	fmt.Fprintf(w, "new(map[string]int):\n")
+	fmt.Fprintf(w, "  Type: %T (pointer to map)\n", mapNew)
+	fmt.Fprintf(w, "  Value: %v (pointer to nil map)\n", mapNew)
+	fmt.Fprintf(w, "  Dereferenced: %v, is nil? %v\n", *mapNew, *mapNew == nil)
 	// Cannot assign to nil map - must initialize first
 	*mapNew = make(map[string]int)
 	(*mapNew)["key"] = 42
-	fmt.Printf("  After make and assign: %v\n", *mapNew)
+	fmt.Fprintf(w, "  After make and assign: %v\n", *mapNew)
 
 	// Using make() with map - creates ready-to-use map
 	mapMake := make(map[string]int)
-	fmt.Printf("\nmake(map[string]int):\n")
-	fmt.Printf("  Type: %T (map, not pointer)\n", mapMake)
-	fmt.Printf("  Value: %v\n", mapMake)
+	fmt.Fprintf(w, "\nmake(map[string]int):\n")
+	fmt.Fprintf(w, "  Type: %T (map, not pointer)\n", mapMake)
+	fmt.Fprintf(w, "  Value: %v\n", mapMake)
 	// Can use immediately
 	mapMake["key"] = 42
-	fmt.Printf("  After insert: %v\n", mapMake)
+	fmt.Fprintf(w, "  After insert: %v\n", mapMake)
 
-	fmt.Println("\n3. WITH STRUCTS:")
+	fmt.Fprintln(w, "\n3. WITH STRUCTS:")
 
 	type Point struct{ X, Y int }
 
 	// Using new() with struct - creates pointer to zeroed struct
 	pointNew := new(Point)
-	fmt.Printf("new(Point):\n")
-	fmt.Printf("  Type: %T (pointer to struct)\n", pointNew)
-	fmt.Printf("  Value: %+v\n", *pointNew)
+	fmt.Fprintf(w, "new(Point):\n")
+	fmt.Fprintf(w, "  Type: %T (pointer to struct)\n", pointNew)
+	fmt.Fprintf(w, "  Value: %+v\n", *pointNew)
 	pointNew.X = 10
-	fmt.Printf("  After modification: %+v\n", *pointNew)
+	fmt.Fprintf(w, "  After modification: %+v\n", *pointNew)
 
 	// make() DOES NOT work with structs
 	// pointMake := make(Point) // Compilation error!
-	fmt.Printf("\nmake(Point): NOT ALLOWED (compilation error)\n")
-	fmt.Printf("  make() only works with slices, maps, and channels\n")
+	fmt.Fprintf(w, "\nmake(Point): NOT ALLOWED (compilation error)\n")
+	fmt.Fprintf(w, "  make() only works with slices, maps, and channels\n")
 
 	// For structs, use literals or new()
 	pointLiteral := Point{X: 5, Y: 10}
 	pointLiteralPtr := &Point{X: 5, Y: 10}
-	fmt.Printf("\nStruct literal: %+v (type: %T)\n", pointLiteral, pointLiteral)
-	fmt.Printf("Pointer to literal: %+v (type: %T)\n", *pointLiteralPtr, pointLiteralPtr)
+	fmt.Fprintf(w, "\nStruct literal: %+v (type: %T)\n", pointLiteral, pointLiteral)
+	fmt.Fprintf(w, "Pointer to literal: %+v (type: %T)\n", *pointLiteralPtr, pointLiteralPtr)
+
+	fmt.Fprintln(w, "\n4. WITH CHANNELS:")
+
+	// Using new() with channel - creates pointer to nil channel
+	chanNew := new(chan int)
+	fmt.Fprintf(w, "new(chan int):\n")
+	fmt.Fprintf(w, "  Type: %T (pointer to channel)\n", chanNew)
+	fmt.Fprintf(w, "  Dereferenced: %v, is nil? %v\n", *chanNew, *chanNew == nil)
+	// *chanNew <- 1 // Blocks forever: sends and receives on a nil channel never proceed
+	fmt.Fprintf(w, "  Unusable until assigned: *chanNew = make(chan int)\n")
+
+	// Using make() with channel - unbuffered
+	chanMake := make(chan int)
+	fmt.Fprintf(w, "\nmake(chan int):\n")
+	fmt.Fprintf(w, "  Type: %T (channel, not pointer)\n", chanMake)
+	fmt.Fprintf(w, "  Length: %d, Capacity: %d (unbuffered: a send waits for a receiver)\n",
+		len(chanMake), cap(chanMake))
+
+	// Using make() with channel - buffered
+	chanBuffered := make(chan int, 5)
+	chanBuffered <- 1 // Doesn't block: there is room in the buffer
+	chanBuffered <- 2
+	fmt.Fprintf(w, "\nmake(chan int, 5):\n")
+	fmt.Fprintf(w, "  Type: %T\n", chanBuffered)
+	fmt.Fprintf(w, "  After 2 sends - Length: %d, Capacity: %d\n", len(chanBuffered), cap(chanBuffered))
+	fmt.Fprintf(w, "  Received: %d (FIFO)\n", <-chanBuffered)
+
+	fmt.Fprintf(w, "\nNote: sending to (or receiving from) a nil channel blocks forever.\n")
+	fmt.Fprintf(w, "  In a select, that's useful: set a case's channel to nil to disable it.\n")
 }
 
 // WhenToUseWhat provides guidance on when to use new() vs make()
-func WhenToUseWhat() {
-	fmt.Println("\n=== WHEN TO USE WHAT ===")
-
-	fmt.Println("\nUse make() for:")
-	fmt.Println("  ✓ Slices   - make([]T, len, cap)")
-	fmt.Println("  ✓ Maps     - make(map[K]V)")
-	fmt.Println("  ✓ Channels - make(chan T)")
-	fmt.Println("  → Returns initialized, ready-to-use value")
-
-	fmt.Println("\nUse new() for:")
-	fmt.Println("  ✓ Any type when you need a pointer to zero value")
-	fmt.Println("  ✓ Rarely used in practice")
-	fmt.Println("  → Returns pointer to zeroed memory")
-
-	fmt.Println("\nIn practice:")
-	fmt.Println("  → Slices: Use make() or literal []T{}")
-	fmt.Println("  → Maps: Use make() or literal map[K]V{}")
-	fmt.Println("  → Structs: Use literal T{} or &T{}")
-	fmt.Println("  → new() is rarely needed")
+func WhenToUseWhat(w io.Writer) {
+	heading(w, "WHEN TO USE WHAT")
+
+	fmt.Fprintln(w, "\nUse make() for:")
+	fmt.Fprintln(w, "  "+okMark(w)+" Slices   - make([]T, len, cap)")
+	fmt.Fprintln(w, "  "+okMark(w)+" Maps     - make(map[K]V)")
+	fmt.Fprintln(w, "  "+okMark(w)+" Channels - make(chan T)")
+	fmt.Fprintln(w, "  → Returns initialized, ready-to-use value")
+
+	fmt.Fprintln(w, "\nUse new() for:")
+	fmt.Fprintln(w, "  "+okMark(w)+" Any type when you need a pointer to zero value")
+	fmt.Fprintln(w, "  "+okMark(w)+" Rarely used in practice")
+	fmt.Fprintln(w, "  → Returns pointer to zeroed memory")
+
+	fmt.Fprintln(w, "\nIn practice:")
+	fmt.Fprintln(w, "  → Slices: Use make() or literal []T{}")
+	fmt.Fprintln(w, "  → Maps: Use make() or literal map[K]V{}")
+	fmt.Fprintln(w, "  → Structs: Use literal T{} or &T{}")
+	fmt.Fprintln(w, "  → new() is rarely needed")
 }
 
 // PracticalExamples shows idiomatic usage patterns
-func PracticalExamples() {
-	fmt.Println("\n=== PRACTICAL EXAMPLES ===")
+func PracticalExamples(w io.Writer) {
+	heading(w, "PRACTICAL EXAMPLES")
 
 	// Example 1: Creating slices
-	fmt.Println("\nCreating slices (IDIOMATIC):")
+	fmt.Fprintln(w, "\nCreating slices (IDIOMATIC):")
 
 	// Empty slice - use literal or make
 	var empty1 []int         // nil slice
 	empty2 := []int{}        // empty slice literal
 	empty3 := make([]int, 0) // empty slice with make
-	fmt.Printf("  nil slice: %v\n", empty1)
-	fmt.Printf("  empty literal: %v\n", empty2)
-	fmt.Printf("  make empty: %v\n", empty3)
+	fmt.Fprintf(w, "  nil slice: %v\n", empty1)
+	fmt.Fprintf(w, "  empty literal: %v\n", empty2)
+	fmt.Fprintf(w, "  make empty: %v\n", empty3)
 
 	// Slice with known size
 	sized := make([]int, 10) // preferred
-	fmt.Printf("  sized slice: len=%d, cap=%d\n", len(sized), cap(sized))
+	fmt.Fprintf(w, "  sized slice: len=%d, cap=%d\n", len(sized), cap(sized))
 
 	// Slice with initial values
 	initialized := []int{1, 2, 3, 4, 5}
-	fmt.Printf("  initialized: %v\n", initialized)
+	fmt.Fprintf(w, "  initialized: %v\n", initialized)
 
 	// Example 2: Creating maps
-	fmt.Println("\nCreating maps (IDIOMATIC):")
+	fmt.Fprintln(w, "\nCreating maps (IDIOMATIC):")
 
 	// Empty map
 	var nilMap map[string]int        // nil map (can't use!)
 	emptyMap := make(map[string]int) // preferred
-	fmt.Printf("  nil map: %v (can't insert!)\n", nilMap)
-	fmt.Printf("  empty map: %v (ready to use)\n", emptyMap)
+	fmt.Fprintf(w, "  nil map: %v (can't insert!)\n", nilMap)
+	fmt.Fprintf(w, "  empty map: %v (ready to use)\n", emptyMap)
 
 	// Map with initial values
 	initialized2 := map[string]int{
 		"one": 1,
 		"two": 2,
 	}
-	fmt.Printf("  initialized: %v\n", initialized2)
+	fmt.Fprintf(w, "  initialized: %v\n", initialized2)
 
 	// Example 3: Creating structs
-	fmt.Println("\nCreating structs (IDIOMATIC):")
+	fmt.Fprintln(w, "\nCreating structs (IDIOMATIC):")
 
 	type Config struct {
 		Host string
@@ -233,83 +261,83 @@ func PracticalExamples() {
 	literalPtr := &Config{Host: "example.com", Port: 443}
 	withNew := new(Config) // rarely used
 
-	fmt.Printf("  zero value: %+v\n", zero)
-	fmt.Printf("  literal: %+v\n", literal)
-	fmt.Printf("  pointer to literal: %+v\n", *literalPtr)
-	fmt.Printf("  with new: %+v\n", *withNew)
+	fmt.Fprintf(w, "  zero value: %+v\n", zero)
+	fmt.Fprintf(w, "  literal: %+v\n", literal)
+	fmt.Fprintf(w, "  pointer to literal: %+v\n", *literalPtr)
+	fmt.Fprintf(w, "  with new: %+v\n", *withNew)
 }
 
 // MemoryAllocationDetails shows what happens behind the scenes
-func MemoryAllocationDetails() {
-	fmt.Println("\n=== MEMORY ALLOCATION DETAILS ===")
+func MemoryAllocationDetails(w io.Writer) {
+	heading(w, "MEMORY ALLOCATION DETAILS")
 
 	// new() allocates zeroed memory
 	intPtr := new(int)
-	fmt.Printf("new(int): address=%p, value=%d\n", intPtr, *intPtr)
+	fmt.Fprintf(w, "new(int): address=%p, value=%d\n", intPtr, *intPtr)
 
 	// Equivalent to:
 	var i int
 	intPtr2 := &i
-	fmt.Printf("var + &:  address=%p, value=%d\n", intPtr2, *intPtr2)
+	fmt.Fprintf(w, "var + &:  address=%p, value=%d\n", intPtr2, *intPtr2)
 
 	// make() for slices allocates backing array
 	slice := make([]int, 3, 5)
-	fmt.Printf("\nmake([]int, 3, 5):\n")
-	fmt.Printf("  Slice header: len=%d, cap=%d\n", len(slice), cap(slice))
-	fmt.Printf("  Backing array allocated with capacity 5\n")
-	fmt.Printf("  Elements initialized to zero: %v\n", slice)
+	fmt.Fprintf(w, "\nmake([]int, 3, 5):\n")
+	fmt.Fprintf(w, "  Slice header: len=%d, cap=%d\n", len(slice), cap(slice))
+	fmt.Fprintf(w, "  Backing array allocated with capacity 5\n")
+	fmt.Fprintf(w, "  Elements initialized to zero: %v\n", slice)
 
 	// make() for maps allocates hash table
 	m := make(map[string]int, 100)
-	fmt.Printf("\nmake(map[string]int, 100):\n")
-	fmt.Printf("  Hash table allocated with space for ~100 elements\n")
-	fmt.Printf("  Ready for immediate use: %v\n", m)
+	fmt.Fprintf(w, "\nmake(map[string]int, 100):\n")
+	fmt.Fprintf(w, "  Hash table allocated with space for ~100 elements\n")
+	fmt.Fprintf(w, "  Ready for immediate use: %v\n", m)
 }
 
 // CommonMistakes shows common errors when using new() and make()
-func CommonMistakes() {
-	fmt.Println("\n=== COMMON MISTAKES ===")
+func CommonMistakes(w io.Writer) {
+	heading(w, "COMMON MISTAKES")
 
 	// Mistake 1: Using new() with maps/slices expecting them to work
-	fmt.Println("\nMistake 1: Trying to use new() with maps")
+	fmt.Fprintln(w, "\nMistake 1: Trying to use new() with maps")
 	mapPtr := new(map[string]int)
-	fmt.Printf("  new(map[string]int) creates: %T\n", mapPtr)
-	fmt.Printf("  Value: %v (pointer to nil map)\n", mapPtr)
+	fmt.Fprintf(w, "  new(map[string]int) creates: %T\n", mapPtr)
+	fmt.Fprintf(w, "  Value: %v (pointer to nil map)\n", mapPtr)
 	// (*mapPtr)["key"] = 1 // PANIC! Assignment to nil map
-	fmt.Println("  ❌ Can't insert - map is nil!")
+	fmt.Fprintln(w, "  "+failMark(w)+" Can't insert - map is nil!")
 
 	// Solution: Use make()
 	goodMap := make(map[string]int)
 	goodMap["key"] = 1
-	fmt.Printf("  ✓ make(map[string]int) works: %v\n", goodMap)
+	fmt.Fprintf(w, "  %s make(map[string]int) works: %v\n", okMark(w), goodMap)
 
 	// Mistake 2: Using make() with structs
-	fmt.Println("\nMistake 2: Trying to use make() with structs")
+	fmt.Fprintln(w, "\nMistake 2: Trying to use make() with structs")
 	// type Point struct{ X, Y int }
 	// p := make(Point) // COMPILATION ERROR!
-	fmt.Println("  ❌ make(Point) doesn't compile")
-	fmt.Println("  ✓ Use Point{} or new(Point) instead")
+	fmt.Fprintln(w, "  "+failMark(w)+" make(Point) doesn't compile")
+	fmt.Fprintln(w, "  "+okMark(w)+" Use Point{} or new(Point) instead")
 
 	// Mistake 3: Confusing return types
-	fmt.Println("\nMistake 3: Forgetting new() returns pointer")
+	fmt.Fprintln(w, "\nMistake 3: Forgetting new() returns pointer")
 	intPtr := new(int)
 	// var x int = intPtr // Type error! intPtr is *int, not int
 	var x int = *intPtr // Must dereference
-	fmt.Printf("  new(int) returns: %T (need to dereference)\n", intPtr)
-	fmt.Printf("  Dereferenced value: %d (type: %T)\n", x, x)
+	fmt.Fprintf(w, "  new(int) returns: %T (need to dereference)\n", intPtr)
+	fmt.Fprintf(w, "  Dereferenced value: %d (type: %T)\n", x, x)
 }
 
 // RunNewVsMake runs all new vs make examples
-func RunNewVsMake() {
-	fmt.Println("\n" + strings.Repeat("=", 60))
-	fmt.Println("new() vs make() IN GO")
-	fmt.Println(strings.Repeat("=", 60))
-
-	NewBasics()
-	MakeBasics()
-	NewVsMakeComparison()
-	WhenToUseWhat()
-	PracticalExamples()
-	MemoryAllocationDetails()
-	CommonMistakes()
+func RunNewVsMake(w io.Writer) {
+	topicBanner("new() vs make() IN GO", w)
+
+	NewBasics(w)
+	MakeBasics(w)
+	NewVsMakeComparison(w)
+	WhenToUseWhat(w)
+	PracticalExamples(w)
+	MemoryAllocationDetails(w)
+	MemoryFinalizers(w)
+	SyncPoolExample(w)
+	CommonMistakes(w)
 }
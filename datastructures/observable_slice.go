@@ -0,0 +1,82 @@
+package datastructures
+
+import (
+	"fmt"
+	"io"
+)
+
+// OBSERVABLE SLICE
+// ================
+// Wraps a slice and notifies registered observers after every mutation.
+// - Combines slice operations with the observer pattern
+// - Observers are plain functions (no interface needed)
+
+// ObservableSlice is a slice that fires callbacks when it changes
+type ObservableSlice[T any] struct {
+	items     []T
+	observers []func(op string, index int, value T)
+}
+
+// OnChange registers an observer called after each mutation.
+// op is "append" or "remove"; index and value describe the affected element.
+func (s *ObservableSlice[T]) OnChange(fn func(op string, index int, value T)) {
+	s.observers = append(s.observers, fn)
+}
+
+// Append adds v to the end and notifies observers
+func (s *ObservableSlice[T]) Append(v T) {
+	s.items = append(s.items, v)
+	s.notify("append", len(s.items)-1, v)
+}
+
+// RemoveAt deletes the element at index, preserving order.
+// Returns the removed value and false if index is out of range.
+func (s *ObservableSlice[T]) RemoveAt(index int) (T, bool) {
+	if index < 0 || index >= len(s.items) {
+		var zero T
+		return zero, false
+	}
+	removed := s.items[index]
+	s.items = append(s.items[:index], s.items[index+1:]...)
+	s.notify("remove", index, removed)
+	return removed, true
+}
+
+// Len returns the number of elements
+func (s *ObservableSlice[T]) Len() int {
+	return len(s.items)
+}
+
+// Values returns a copy of the elements (callers can't bypass observers)
+func (s *ObservableSlice[T]) Values() []T {
+	out := make([]T, len(s.items))
+	copy(out, s.items)
+	return out
+}
+
+func (s *ObservableSlice[T]) notify(op string, index int, value T) {
+	for _, fn := range s.observers {
+		fn(op, index, value)
+	}
+}
+
+// SlicePatternObservable demonstrates notifying observers on mutation
+func SlicePatternObservable(w io.Writer) {
+	heading(w, "PATTERN: OBSERVABLE SLICE")
+
+	var todos ObservableSlice[string]
+	todos.OnChange(func(op string, index int, value string) {
+		fmt.Fprintf(w, "  [observer] %s at index %d: %q\n", op, index, value)
+	})
+
+	todos.Append("write code")
+	todos.Append("write tests")
+	todos.Append("ship it")
+	todos.RemoveAt(1)
+
+	if _, ok := todos.RemoveAt(10); !ok {
+		fmt.Fprintln(w, "  RemoveAt(10): out of range, no notification")
+	}
+
+	fmt.Fprintf(w, "Final values: %v (len=%d)\n", todos.Values(), todos.Len())
+}
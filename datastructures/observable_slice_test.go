@@ -0,0 +1,56 @@
+package datastructures
+
+import (
+	"slices"
+	"testing"
+)
+
+type change struct {
+	op    string
+	index int
+	value string
+}
+
+func TestObservableSliceNotifies(t *testing.T) {
+	var s ObservableSlice[string]
+	var got []change
+	s.OnChange(func(op string, index int, value string) {
+		got = append(got, change{op, index, value})
+	})
+
+	s.Append("a")
+	s.Append("b")
+	s.Append("c")
+	if v, ok := s.RemoveAt(1); !ok || v != "b" {
+		t.Errorf("RemoveAt(1) = %q, %v; want \"b\", true", v, ok)
+	}
+	if _, ok := s.RemoveAt(5); ok {
+		t.Error("RemoveAt(5) on a 2-element slice succeeded")
+	}
+
+	want := []change{
+		{"append", 0, "a"},
+		{"append", 1, "b"},
+		{"append", 2, "c"},
+		{"remove", 1, "b"},
+		// the out-of-range RemoveAt must not notify
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("observer got %+v, want %+v", got, want)
+	}
+	if vals := s.Values(); !slices.Equal(vals, []string{"a", "c"}) {
+		t.Errorf("Values() = %q, want [a c]", vals)
+	}
+}
+
+func TestObservableSliceMultipleObservers(t *testing.T) {
+	var s ObservableSlice[int]
+	calls := 0
+	for range 2 {
+		s.OnChange(func(string, int, int) { calls++ })
+	}
+	s.Append(1)
+	if calls != 2 {
+		t.Errorf("2 observers were called %d times for one Append, want 2", calls)
+	}
+}
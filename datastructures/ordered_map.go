@@ -0,0 +1,111 @@
+package datastructures
+
+import (
+	"fmt"
+	"io"
+	"slices"
+)
+
+// ORDERED MAP (Insertion Order)
+// =============================
+// Go maps iterate in random order. When output must follow insertion
+// order (config files, JSON-like records, UI lists), pair the map with a
+// slice of keys:
+// - The map gives O(1) Get and Set
+// - The slice remembers the order; Delete is O(n) because it must be
+//   removed from the slice too
+// Chosen behavior: Set on an existing key updates it IN PLACE, but Delete
+// followed by Set treats it as a new key and moves it to the end.
+
+// OrderedMap is a map that iterates in insertion order.
+// The zero value is not usable; create one with NewOrderedMap.
+type OrderedMap[K comparable, V any] struct {
+	values map[K]V
+	keys   []K
+}
+
+// NewOrderedMap returns an empty OrderedMap
+func NewOrderedMap[K comparable, V any]() *OrderedMap[K, V] {
+	return &OrderedMap[K, V]{values: make(map[K]V)}
+}
+
+// Set stores v under k. A new key goes to the end of the order; an
+// existing key keeps its position.
+func (m *OrderedMap[K, V]) Set(k K, v V) {
+	if _, exists := m.values[k]; !exists {
+		m.keys = append(m.keys, k)
+	}
+	m.values[k] = v
+}
+
+// Get returns the value for k and whether it was present
+func (m *OrderedMap[K, V]) Get(k K) (V, bool) {
+	v, ok := m.values[k]
+	return v, ok
+}
+
+// Delete removes k, reporting whether it was present
+func (m *OrderedMap[K, V]) Delete(k K) bool {
+	if _, exists := m.values[k]; !exists {
+		return false
+	}
+	delete(m.values, k)
+	m.keys = slices.DeleteFunc(m.keys, func(key K) bool { return key == k })
+	return true
+}
+
+// Len returns the number of entries
+func (m *OrderedMap[K, V]) Len() int {
+	return len(m.keys)
+}
+
+// Keys returns a copy of the keys in insertion order
+func (m *OrderedMap[K, V]) Keys() []K {
+	return slices.Clone(m.keys)
+}
+
+// Range calls f for each entry in insertion order, stopping early if f
+// returns false
+func (m *OrderedMap[K, V]) Range(f func(k K, v V) bool) {
+	for _, k := range m.keys {
+		if !f(k, m.values[k]) {
+			return
+		}
+	}
+}
+
+// MapPatternOrdered demonstrates insertion-ordered iteration
+func MapPatternOrdered(w io.Writer) {
+	heading(w, "PATTERN: ORDERED MAP (Insertion Order)")
+
+	steps := NewOrderedMap[string, int]()
+	steps.Set("checkout", 1)
+	steps.Set("build", 2)
+	steps.Set("test", 3)
+	steps.Set("deploy", 4)
+
+	// Same order every run, unlike ranging over a plain map
+	for i := 0; i < 2; i++ {
+		fmt.Fprintf(w, "Run %d: %v\n", i+1, steps.Keys())
+	}
+
+	steps.Set("build", 20) // existing key: value changes, position doesn't
+	fmt.Fprint(w, "After Set(\"build\", 20):")
+	steps.Range(func(k string, v int) bool {
+		fmt.Fprintf(w, " %s=%d", k, v)
+		return true
+	})
+	fmt.Fprintln(w)
+
+	steps.Delete("test")
+	steps.Set("test", 30) // deleted then re-added: goes to the end
+	fmt.Fprintf(w, "After Delete+Set(\"test\"): %v\n", steps.Keys())
+
+	// Range stops when f returns false
+	fmt.Fprint(w, "Range until \"build\":")
+	steps.Range(func(k string, _ int) bool {
+		fmt.Fprintf(w, " %s", k)
+		return k != "build"
+	})
+	fmt.Fprintln(w)
+}
@@ -0,0 +1,105 @@
+package datastructures
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+)
+
+// entries collects m's pairs through Range, as "key=value"
+func entries(m *OrderedMap[string, int]) []string {
+	var out []string
+	m.Range(func(k string, v int) bool {
+		out = append(out, k+"="+strconv.Itoa(v))
+		return true
+	})
+	return out
+}
+
+func TestOrderedMapInsertionOrder(t *testing.T) {
+	m := NewOrderedMap[string, int]()
+	for i, k := range []string{"zebra", "apple", "mango", "banana"} {
+		m.Set(k, i)
+	}
+	want := []string{"zebra", "apple", "mango", "banana"}
+	// Repeat: a plain map would eventually come out in a different order
+	for range 20 {
+		if got := m.Keys(); !slices.Equal(got, want) {
+			t.Fatalf("Keys() = %v, want %v", got, want)
+		}
+	}
+	if got, want := entries(m), []string{"zebra=0", "apple=1", "mango=2", "banana=3"}; !slices.Equal(got, want) {
+		t.Errorf("Range = %v, want %v", got, want)
+	}
+	if v, ok := m.Get("mango"); !ok || v != 2 {
+		t.Errorf("Get(\"mango\") = %d, %v, want 2, true", v, ok)
+	}
+	if v, ok := m.Get("kiwi"); ok || v != 0 {
+		t.Errorf("Get(\"kiwi\") = %d, %v, want 0, false", v, ok)
+	}
+
+	// Keys returns a copy
+	keys := m.Keys()
+	keys[0] = "changed"
+	if got := m.Keys()[0]; got != "zebra" {
+		t.Errorf("Keys()[0] = %q after editing the returned slice, want %q", got, "zebra")
+	}
+}
+
+func TestOrderedMapReinsert(t *testing.T) {
+	m := NewOrderedMap[string, int]()
+	m.Set("a", 1)
+	m.Set("b", 2)
+	m.Set("c", 3)
+
+	// Updating an existing key keeps its position
+	m.Set("a", 9)
+	if got, want := entries(m), []string{"a=9", "b=2", "c=3"}; !slices.Equal(got, want) {
+		t.Errorf("after Set(a, 9): %v, want %v", got, want)
+	}
+
+	// Delete then Set moves it to the end
+	if !m.Delete("a") {
+		t.Error("Delete(\"a\") = false, want true")
+	}
+	m.Set("a", 1)
+	if got, want := entries(m), []string{"b=2", "c=3", "a=1"}; !slices.Equal(got, want) {
+		t.Errorf("after Delete(a), Set(a, 1): %v, want %v", got, want)
+	}
+	if m.Len() != 3 {
+		t.Errorf("Len() = %d, want 3", m.Len())
+	}
+}
+
+func TestOrderedMapDelete(t *testing.T) {
+	m := NewOrderedMap[string, int]()
+	m.Set("a", 1)
+	m.Set("b", 2)
+	m.Set("c", 3)
+
+	if m.Delete("missing") {
+		t.Error("Delete(\"missing\") = true, want false")
+	}
+	if !m.Delete("b") {
+		t.Error("Delete(\"b\") = false, want true")
+	}
+	if m.Delete("b") {
+		t.Error("second Delete(\"b\") = true, want false")
+	}
+	if got, want := m.Keys(), []string{"a", "c"}; !slices.Equal(got, want) {
+		t.Errorf("Keys() after Delete(b) = %v, want %v", got, want)
+	}
+	if _, ok := m.Get("b"); ok || m.Len() != 2 {
+		t.Errorf("after Delete(b): Get ok = %v, Len = %d, want false, 2", ok, m.Len())
+	}
+
+	// Range stops when f returns false
+	var seen []string
+	m.Range(func(k string, _ int) bool {
+		seen = append(seen, k)
+		return false
+	})
+	if want := []string{"a"}; !slices.Equal(seen, want) {
+		t.Errorf("Range stopping after one = %v, want %v", seen, want)
+	}
+}
@@ -0,0 +1,64 @@
+package datastructures
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+)
+
+// GENERIC PAIR
+// ============
+// Returning two related values per element (word + count, key + value)
+// usually means an anonymous struct, which has to be spelled out again
+// in every signature that mentions it. Pair[A, B] names that shape once:
+// - Pair[string, int] and Pair[int, string] are different types, so
+//   Swap returns Pair[B, A]
+// - Zip and Unzip (slice_utils.go) use it too
+
+// Pair holds two values of possibly different types
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Swap returns a pair with First and Second exchanged
+func (p Pair[A, B]) Swap() Pair[B, A] {
+	return Pair[B, A]{First: p.Second, Second: p.First}
+}
+
+// String implements fmt.Stringer: "(apple, 3)"
+func (p Pair[A, B]) String() string {
+	return fmt.Sprintf("(%v, %v)", p.First, p.Second)
+}
+
+// MakePairs pairs as[i] with bs[i]. If the lengths differ, the result is
+// as long as the SHORTER input and the extra elements are dropped.
+func MakePairs[A, B any](as []A, bs []B) []Pair[A, B] {
+	n := min(len(as), len(bs))
+	out := make([]Pair[A, B], n)
+	for i := range n {
+		out[i] = Pair[A, B]{First: as[i], Second: bs[i]}
+	}
+	return out
+}
+
+// WordCounts counts each distinct word and returns (word, count) pairs,
+// most frequent first and alphabetical among ties, so the order is
+// stable unlike ranging over the counting map
+func WordCounts(words []string) []Pair[string, int] {
+	counts := make(map[string]int)
+	for _, word := range words {
+		counts[word]++
+	}
+	out := make([]Pair[string, int], 0, len(counts))
+	for word, n := range counts {
+		out = append(out, Pair[string, int]{First: word, Second: n})
+	}
+	slices.SortFunc(out, func(a, b Pair[string, int]) int {
+		if c := cmp.Compare(b.Second, a.Second); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.First, b.First)
+	})
+	return out
+}
@@ -0,0 +1,54 @@
+package datastructures
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestPairSwap(t *testing.T) {
+	p := Pair[string, int]{First: "apple", Second: 3}
+	s := p.Swap()
+	var _ Pair[int, string] = s // Swap flips the type parameters too
+	if s.First != 3 || s.Second != "apple" {
+		t.Errorf("Swap() = %+v, want {3 apple}", s)
+	}
+	if s.Swap() != p {
+		t.Errorf("Swap().Swap() = %+v, want %+v", s.Swap(), p)
+	}
+	if got := p.String(); got != "(apple, 3)" {
+		t.Errorf("String() = %q, want %q", got, "(apple, 3)")
+	}
+}
+
+func TestMakePairsMismatchedLengths(t *testing.T) {
+	tests := []struct {
+		name string
+		as   []string
+		bs   []int
+		want []Pair[string, int]
+	}{
+		{"equal", []string{"a", "b"}, []int{1, 2}, []Pair[string, int]{{"a", 1}, {"b", 2}}},
+		{"first longer", []string{"a", "b", "c"}, []int{1}, []Pair[string, int]{{"a", 1}}},
+		{"second longer", []string{"a"}, []int{1, 2, 3}, []Pair[string, int]{{"a", 1}}},
+		{"one nil", nil, []int{1}, []Pair[string, int]{}},
+	}
+	for _, tt := range tests {
+		got := MakePairs(tt.as, tt.bs)
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%s: MakePairs = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestWordCounts(t *testing.T) {
+	got := WordCounts([]string{"b", "a", "c", "a", "b", "a"})
+	want := []Pair[string, int]{{"a", 3}, {"b", 2}, {"c", 1}}
+	if !slices.Equal(got, want) {
+		t.Errorf("WordCounts = %v, want %v", got, want)
+	}
+	// Ties are broken alphabetically
+	got = WordCounts([]string{"y", "x"})
+	if want := []Pair[string, int]{{"x", 1}, {"y", 1}}; !slices.Equal(got, want) {
+		t.Errorf("WordCounts with ties = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,95 @@
+package datastructures
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// INFIX TO POSTFIX (Shunting Yard)
+// ================================
+// Dijkstra's shunting-yard algorithm uses a slice as an operator stack
+// to turn "3 + 4 * 2" into "3 4 2 * +" (Reverse Polish Notation).
+// - Operands go straight to the output
+// - Operators wait on the stack until a lower-precedence one arrives
+// - Parentheses group sub-expressions
+
+// ErrMismatchedParens is returned when parentheses don't pair up
+var ErrMismatchedParens = errors.New("mismatched parentheses")
+
+// precedence maps each supported operator to its binding strength
+var precedence = map[string]int{
+	"+": 1,
+	"-": 1,
+	"*": 2,
+	"/": 2,
+}
+
+// ToPostfix converts a space-separated infix expression into postfix tokens.
+// All operators are left-associative, so "8 - 3 - 2" becomes "8 3 - 2 -".
+func ToPostfix(expr string) ([]string, error) {
+	var output []string
+	var ops []string // operator stack (top is the last element)
+
+	for _, tok := range strings.Fields(expr) {
+		switch {
+		case tok == "(":
+			ops = append(ops, tok)
+		case tok == ")":
+			// Pop until the matching "(" is found
+			for len(ops) > 0 && ops[len(ops)-1] != "(" {
+				output = append(output, ops[len(ops)-1])
+				ops = ops[:len(ops)-1]
+			}
+			if len(ops) == 0 {
+				return nil, ErrMismatchedParens
+			}
+			ops = ops[:len(ops)-1] // discard "("
+		case precedence[tok] > 0:
+			// Left-associative: pop operators of greater OR EQUAL precedence
+			for len(ops) > 0 && precedence[ops[len(ops)-1]] >= precedence[tok] {
+				output = append(output, ops[len(ops)-1])
+				ops = ops[:len(ops)-1]
+			}
+			ops = append(ops, tok)
+		default:
+			output = append(output, tok) // operand
+		}
+	}
+
+	// Drain remaining operators; a leftover "(" was never closed
+	for len(ops) > 0 {
+		top := ops[len(ops)-1]
+		if top == "(" {
+			return nil, ErrMismatchedParens
+		}
+		output = append(output, top)
+		ops = ops[:len(ops)-1]
+	}
+
+	return output, nil
+}
+
+// SlicePatternShuntingYard demonstrates a slice used as a stack
+func SlicePatternShuntingYard(w io.Writer) {
+	heading(w, "PATTERN: SLICE AS A STACK (Shunting Yard)")
+
+	expressions := []string{
+		"3 + 4",
+		"3 + 4 * 2",
+		"( 3 + 4 ) * 2",
+		"8 - 3 - 2",
+		"( 1 + 2 ) * ( 3 - 4 ) / 5",
+		"( 1 + 2",
+	}
+
+	for _, expr := range expressions {
+		postfix, err := ToPostfix(expr)
+		if err != nil {
+			fmt.Fprintf(w, "  %-28s → error: %v\n", expr, err)
+			continue
+		}
+		fmt.Fprintf(w, "  %-28s → %s\n", expr, strings.Join(postfix, " "))
+	}
+}
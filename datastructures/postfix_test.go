@@ -0,0 +1,46 @@
+package datastructures
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestToPostfix(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{"", ""},
+		{"7", "7"},
+		{"3 + 4", "3 4 +"},
+		{"3 + 4 * 2", "3 4 2 * +"},
+		{"3 * 4 + 2", "3 4 * 2 +"},
+		{"8 - 3 - 2", "8 3 - 2 -"},     // left-associative
+		{"8 / 4 / 2", "8 4 / 2 /"},     // left-associative
+		{"( 3 + 4 ) * 2", "3 4 + 2 *"}, // parentheses beat precedence
+		{"8 - ( 3 - 2 )", "8 3 2 - -"},
+		{"( 1 + 2 ) * ( 3 - 4 ) / 5", "1 2 + 3 4 - * 5 /"},
+		{"( ( 1 + 2 ) * 3 + 4 ) * 5", "1 2 + 3 * 4 + 5 *"},
+	}
+	for _, tt := range tests {
+		got, err := ToPostfix(tt.expr)
+		if err != nil {
+			t.Errorf("ToPostfix(%q): unexpected error %v", tt.expr, err)
+			continue
+		}
+		if want := strings.Fields(tt.want); !slices.Equal(got, want) {
+			t.Errorf("ToPostfix(%q) = %q, want %q", tt.expr, got, want)
+		}
+	}
+}
+
+func TestToPostfixMismatchedParens(t *testing.T) {
+	for _, expr := range []string{"( 1 + 2", "1 + 2 )", ") 1 (", "( ( 1 )"} {
+		got, err := ToPostfix(expr)
+		if !errors.Is(err, ErrMismatchedParens) {
+			t.Errorf("ToPostfix(%q) = %q, %v; want ErrMismatchedParens", expr, got, err)
+		}
+	}
+}
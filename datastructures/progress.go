@@ -0,0 +1,110 @@
+package datastructures
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"strings"
+)
+
+// LEARNING PROGRESS
+// =================
+// Remembers which topics a learner has finished, across runs, in a small
+// JSON file: {"done": {"maps": true, "structs": true}}
+// - The summary groups topics by their registry Module
+// - A missing file just means nothing has been done yet
+// - Every MarkDone saves immediately, so quitting never loses progress
+
+// Progress tracks completed topics, persisted to a JSON file
+type Progress struct {
+	path string
+	Done map[string]bool `json:"done"`
+}
+
+// LoadProgress reads progress from path. A missing file is not an error:
+// it returns empty progress that will be saved to path.
+func LoadProgress(path string) (*Progress, error) {
+	p := &Progress{path: path, Done: map[string]bool{}}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return p, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("load progress: %w", err)
+	}
+	if err := json.Unmarshal(data, p); err != nil {
+		return nil, fmt.Errorf("load progress %s: %w", path, err)
+	}
+	if p.Done == nil { // file contained {} or "done": null
+		p.Done = map[string]bool{}
+	}
+	return p, nil
+}
+
+// MarkDone records that the named topic was completed and saves the file
+func (p *Progress) MarkDone(name string) error {
+	p.Done[name] = true
+	return p.save()
+}
+
+func (p *Progress) save() error {
+	data, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return fmt.Errorf("save progress: %w", err)
+	}
+	if err := os.WriteFile(p.path, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("save progress: %w", err)
+	}
+	return nil
+}
+
+// Summary reports, for each module, its completion percentage and which
+// of its topics are done, followed by the overall percentage
+func (p *Progress) Summary() string {
+	var b strings.Builder
+	p.WriteSummary(&b)
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// WriteSummary writes the Summary to w, coloring the done marks if w
+// has color on
+func (p *Progress) WriteSummary(w io.Writer) {
+	// Modules in order of their first topic; registry need not keep a
+	// module's topics together
+	var modules []string
+	topics := make(map[string][]Topic)
+	for _, t := range registry {
+		if _, ok := topics[t.Module]; !ok {
+			modules = append(modules, t.Module)
+		}
+		topics[t.Module] = append(topics[t.Module], t)
+	}
+
+	total := 0
+	for _, m := range modules {
+		done := 0
+		for _, t := range topics[m] {
+			if p.Done[t.Key] {
+				done++
+			}
+		}
+		total += done
+		fmt.Fprintf(w, "%s: %s\n", m, percent(done, len(topics[m])))
+		for _, t := range topics[m] {
+			mark := "[ ]"
+			if p.Done[t.Key] {
+				mark = "[" + okMark(w) + "]"
+			}
+			fmt.Fprintf(w, "  %s %s\n", mark, t.Name)
+		}
+	}
+	fmt.Fprintf(w, "Overall: %s\n", percent(total, len(registry)))
+}
+
+// percent formats done out of n as "2/5 (40%)"
+func percent(done, n int) string {
+	return fmt.Sprintf("%d/%d (%d%%)", done, n, done*100/n)
+}
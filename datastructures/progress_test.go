@@ -0,0 +1,100 @@
+package datastructures
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// moduleSize counts the registered topics in module
+func moduleSize(module string) int {
+	n := 0
+	for _, topic := range registry {
+		if topic.Module == module {
+			n++
+		}
+	}
+	return n
+}
+
+func TestProgressMarkDoneUpdatesSummary(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "progress.json")
+	p, err := LoadProgress(path)
+	if err != nil {
+		t.Fatalf("LoadProgress(missing file): %v", err)
+	}
+	// Totals come from the registry so adding a topic doesn't break this test
+	if s, want := p.Summary(), "Overall: "+percent(0, len(registry)); !strings.Contains(s, want) {
+		t.Errorf("fresh Summary() = %q, want it to contain %q", s, want)
+	}
+
+	for _, key := range []string{"maps", "trie", "csv"} {
+		if err := p.MarkDone(key); err != nil {
+			t.Fatalf("MarkDone(%q): %v", key, err)
+		}
+	}
+	summary := p.Summary()
+	for _, want := range []string{
+		"Built-in Types: " + percent(1, moduleSize("Built-in Types")),
+		"  [✓] Maps",
+		"  [ ] Structs",
+		"Data Structures: " + percent(1, moduleSize("Data Structures")),
+		"  [✓] Tries (Prefix Trees)",
+		"Encoding: " + percent(1, moduleSize("Encoding")),
+		"Overall: " + percent(3, len(registry)),
+	} {
+		if !strings.Contains(summary, want) {
+			t.Errorf("Summary() missing %q:\n%s", want, summary)
+		}
+	}
+
+	// Marking a topic twice doesn't count it twice
+	p.MarkDone("maps")
+	if got := p.Summary(); got != summary {
+		t.Errorf("Summary() after a repeated MarkDone changed:\n%s\nwant:\n%s", got, summary)
+	}
+}
+
+func TestProgressSurvivesReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "progress.json")
+	p, err := LoadProgress(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.MarkDone("structs")
+	p.MarkDone("heap")
+
+	reloaded, err := LoadProgress(path)
+	if err != nil {
+		t.Fatalf("LoadProgress after MarkDone: %v", err)
+	}
+	for _, key := range []string{"structs", "heap"} {
+		if !reloaded.Done[key] {
+			t.Errorf("reloaded Done[%q] = false, want true", key)
+		}
+	}
+	if len(reloaded.Done) != 2 {
+		t.Errorf("reloaded Done = %v, want 2 topics", reloaded.Done)
+	}
+	if got, want := reloaded.Summary(), p.Summary(); got != want {
+		t.Errorf("reloaded Summary() =\n%s\nwant:\n%s", got, want)
+	}
+
+	// The reloaded tracker keeps saving to the same file
+	reloaded.MarkDone("csv")
+	again, err := LoadProgress(path)
+	if err != nil || !again.Done["csv"] {
+		t.Errorf("third load: Done[csv] = %v, err = %v, want true, nil", again.Done["csv"], err)
+	}
+}
+
+func TestLoadProgressCorruptFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "progress.json")
+	if err := os.WriteFile(path, []byte("{not json"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadProgress(path); err == nil {
+		t.Error("LoadProgress(corrupt file) = nil error, want one")
+	}
+}
@@ -0,0 +1,114 @@
+package datastructures
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// QUIZ MODE
+// =========
+// Optional multiple-choice questions asked after a topic finishes.
+// Toggled from the menu, so the demos themselves never wait for input.
+
+// Quiz is one multiple-choice question. Correct is the index into
+// Options of the right answer (shown to the user numbered from 1).
+type Quiz struct {
+	Question string
+	Options  []string
+	Correct  int
+}
+
+// quizzes holds the questions for each topic, keyed like -topic names
+var quizzes = map[string][]Quiz{
+	"arrays": {
+		{
+			Question: "What does len() return for make([]int, 3, 10)?",
+			Options:  []string{"10", "3", "0"},
+			Correct:  1,
+		},
+		{
+			Question: "Passing a [1000]int array to a function...",
+			Options:  []string{"copies all 1000 elements", "passes a pointer", "passes a slice header"},
+			Correct:  0,
+		},
+		{
+			Question: "After b := a[1:3], does writing b[0] change a?",
+			Options:  []string{"No, b is a copy", "Yes, they share the backing array", "Only if cap(b) > len(b)"},
+			Correct:  1,
+		},
+	},
+	"maps": {
+		{
+			Question: "Can you assign to a nil map?",
+			Options:  []string{"Yes, it is created on first write", "No, it panics", "Only with string keys"},
+			Correct:  1,
+		},
+		{
+			Question: "What does m[k] return for a missing key?",
+			Options:  []string{"nil", "A panic", "The zero value of the value type"},
+			Correct:  2,
+		},
+		{
+			Question: "Is map iteration order guaranteed?",
+			Options:  []string{"Yes, insertion order", "Yes, sorted by key", "No, it is randomized"},
+			Correct:  2,
+		},
+	},
+	"structs": {
+		{
+			Question: "Assigning one struct variable to another...",
+			Options:  []string{"copies the fields", "shares the same memory", "is a compile error"},
+			Correct:  0,
+		},
+		{
+			Question: "When are two struct values comparable with ==?",
+			Options:  []string{"Always", "When all their fields are comparable", "Never, use reflect.DeepEqual"},
+			Correct:  1,
+		},
+	},
+	"newmake": {
+		{
+			Question: "What does new(T) return?",
+			Options:  []string{"A T", "A *T pointing to a zero value", "An initialized map, slice or channel"},
+			Correct:  1,
+		},
+		{
+			Question: "Which of these can make() create?",
+			Options:  []string{"Structs", "Slices, maps and channels", "Any type"},
+			Correct:  1,
+		},
+	},
+}
+
+// RunQuiz asks each question, reading answers from r, and returns how
+// many were answered correctly. It stops early if r runs out of input.
+func RunQuiz(questions []Quiz, r *bufio.Reader, w io.Writer) (score int) {
+	heading(w, "QUIZ")
+
+	for i, q := range questions {
+		fmt.Fprintf(w, "\nQ%d. %s\n", i+1, q.Question)
+		for j, opt := range q.Options {
+			fmt.Fprintf(w, "  %d) %s\n", j+1, opt)
+		}
+		fmt.Fprint(w, "Answer: ")
+
+		line, err := r.ReadString('\n')
+		if err != nil && line == "" {
+			fmt.Fprintln(w, "\n(no more input, quiz stopped)")
+			break
+		}
+		answer, convErr := strconv.Atoi(strings.TrimSpace(line))
+		if convErr == nil && answer-1 == q.Correct {
+			score++
+			fmt.Fprintln(w, okMark(w)+" Correct!")
+		} else {
+			fmt.Fprintf(w, "%s The answer is %d) %s\n", failMark(w), q.Correct+1, q.Options[q.Correct])
+		}
+	}
+
+	fmt.Fprintf(w, "\nScore: %d/%d\n", score, len(questions))
+	return score
+}
@@ -0,0 +1,75 @@
+package datastructures
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// READING STRUCT TAGS WITH REFLECTION
+// ===================================
+// encoding/json reads tags through the reflect package. So can we:
+// - reflect.TypeOf gives field names, types, and tags
+// - reflect.ValueOf gives the field values
+// - Embedded (anonymous) fields are structs we can recurse into
+
+// InspectStruct prints every field of a struct (or pointer to struct)
+// with its type, value, and json tag
+func InspectStruct(v interface{}, w io.Writer) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Pointer {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		fmt.Fprintf(w, "InspectStruct: %T is not a struct\n", v)
+		return
+	}
+
+	fmt.Fprintf(w, "%s:\n", rv.Type())
+	inspectFields(rv, 1, w)
+}
+
+func inspectFields(rv reflect.Value, depth int, w io.Writer) {
+	indent := strings.Repeat("  ", depth)
+	rt := rv.Type()
+
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		value := rv.Field(i)
+
+		tag := field.Tag.Get("json")
+		if tag == "" {
+			tag = "(none)"
+		}
+
+		if field.Anonymous && value.Kind() == reflect.Struct {
+			// Embedded struct: its fields are promoted, so show them nested
+			fmt.Fprintf(w, "%s%s (embedded %s):\n", indent, field.Name, field.Type)
+			inspectFields(value, depth+1, w)
+			continue
+		}
+
+		// Interface() panics on unexported fields, so only read exported ones
+		shown := "<unexported>"
+		if field.IsExported() {
+			shown = fmt.Sprintf("%v", value.Interface())
+		}
+		fmt.Fprintf(w, "%s%-12s %-8s = %-14s json:%s\n",
+			indent, field.Name, field.Type, shown, tag)
+	}
+}
+
+// StructReflection demonstrates inspecting structs at runtime
+func StructReflection(w io.Writer) {
+	heading(w, "STRUCT REFLECTION (Reading Tags)")
+
+	InspectStruct(Person{Name: "Alice", Age: 30, City: "NYC"}, w)
+	InspectStruct(&Employee{
+		Person:     Person{Name: "Bob", Age: 25, City: "LA"},
+		EmployeeID: 42,
+		Department: "Sales",
+	}, w)
+	InspectStruct(User{ID: 1, Name: "Carol", Password: "secret"}, w)
+	InspectStruct(42, w)
+}
@@ -0,0 +1,71 @@
+package datastructures
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestInspectStructEmbedded(t *testing.T) {
+	var sb strings.Builder
+	InspectStruct(&Employee{
+		Person:     Person{Name: "Bob", Age: 25, City: "LA"},
+		EmployeeID: 42,
+		Department: "Sales",
+	}, &sb)
+	lines := strings.Split(strings.TrimRight(sb.String(), "\n"), "\n")
+
+	// The header names the type; the embedded Person gets its own line,
+	// with its fields one level deeper than Employee's own fields
+	want := []string{
+		"datastructures.Employee:",
+		"  Person (embedded datastructures.Person):",
+		"    Name ",
+		"    Age ",
+		"    City ",
+		"  EmployeeID ",
+		"  Department ",
+	}
+	if len(lines) != len(want) {
+		t.Fatalf("got %d lines, want %d:\n%s", len(lines), len(want), sb.String())
+	}
+	for i, prefix := range want {
+		if !strings.HasPrefix(lines[i], prefix) {
+			t.Errorf("line %d = %q, want prefix %q", i, lines[i], prefix)
+		}
+	}
+	if !strings.Contains(lines[2], "= Bob") || !strings.Contains(lines[5], "= 42") {
+		t.Errorf("values missing:\n%s", sb.String())
+	}
+}
+
+func TestInspectStructAnonymousType(t *testing.T) {
+	v := struct {
+		ID     int `json:"id,omitempty"`
+		secret string
+	}{ID: 7, secret: "hidden"}
+
+	var sb strings.Builder
+	InspectStruct(v, &sb)
+	out := sb.String()
+
+	// An unnamed struct type prints as its literal type
+	if !strings.HasPrefix(out, "struct {") {
+		t.Errorf("header = %q, want the anonymous struct type", strings.SplitN(out, "\n", 2)[0])
+	}
+	for _, want := range []string{"json:id,omitempty", "= 7", "<unexported>"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "hidden") {
+		t.Errorf("unexported field value leaked:\n%s", out)
+	}
+}
+
+func TestInspectStructNonStruct(t *testing.T) {
+	var sb strings.Builder
+	InspectStruct(42, &sb)
+	if got := sb.String(); !strings.Contains(got, "int is not a struct") {
+		t.Errorf("InspectStruct(42) = %q", got)
+	}
+}
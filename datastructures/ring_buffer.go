@@ -0,0 +1,112 @@
+package datastructures
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+// RING BUFFERS (Circular Queues)
+// ==============================
+// A queue with a fixed capacity, stored in a slice that never grows:
+// head marks the oldest element and indexes wrap around with % cap.
+// - No allocation after creation, unlike Queue[T] which re-slices
+// - When full, either overwrite the oldest element (logs, "last N"
+//   metrics) or reject the push (bounded work queues)
+
+// ErrRingFull is returned by Push on a full RingBuffer created with
+// overwrite disabled
+var ErrRingFull = errors.New("ring buffer is full")
+
+// RingBuffer is a fixed-capacity FIFO queue
+type RingBuffer[T any] struct {
+	items     []T
+	head      int // index of the oldest element
+	size      int
+	overwrite bool
+}
+
+// NewRingBuffer creates a ring buffer holding up to capacity elements.
+// With overwrite set, pushing to a full buffer replaces the oldest
+// element; otherwise Push returns ErrRingFull.
+func NewRingBuffer[T any](capacity int, overwrite bool) *RingBuffer[T] {
+	if capacity <= 0 {
+		panic(fmt.Sprintf("NewRingBuffer: capacity must be positive, got %d", capacity))
+	}
+	return &RingBuffer[T]{items: make([]T, capacity), overwrite: overwrite}
+}
+
+// Push adds v as the newest element
+func (r *RingBuffer[T]) Push(v T) error {
+	if r.size == len(r.items) {
+		if !r.overwrite {
+			return ErrRingFull
+		}
+		// Full: the slot after the newest is the oldest, so overwrite it
+		r.items[r.head] = v
+		r.head = (r.head + 1) % len(r.items)
+		return nil
+	}
+	r.items[(r.head+r.size)%len(r.items)] = v
+	r.size++
+	return nil
+}
+
+// Pop removes and returns the oldest element, or ok=false if empty
+func (r *RingBuffer[T]) Pop() (v T, ok bool) {
+	if r.size == 0 {
+		return v, false
+	}
+	v = r.items[r.head]
+	var zero T
+	r.items[r.head] = zero // drop the reference so it can be garbage collected
+	r.head = (r.head + 1) % len(r.items)
+	r.size--
+	return v, true
+}
+
+// Len returns the number of buffered elements
+func (r *RingBuffer[T]) Len() int {
+	return r.size
+}
+
+// Cap returns the fixed capacity
+func (r *RingBuffer[T]) Cap() int {
+	return len(r.items)
+}
+
+// Slice returns a copy of the elements, oldest first
+func (r *RingBuffer[T]) Slice() []T {
+	out := make([]T, r.size)
+	for i := range out {
+		out[i] = r.items[(r.head+i)%len(r.items)]
+	}
+	return out
+}
+
+// RunRingBuffer runs the ring buffer examples
+func RunRingBuffer(w io.Writer) {
+	topicBanner("RING BUFFERS IN GO", w)
+
+	heading(w, "WRAP-AROUND (Overwrite Oldest)")
+	recent := NewRingBuffer[string](3, true)
+	for _, event := range []string{"login", "view", "click", "scroll", "logout"} {
+		recent.Push(event)
+		fmt.Fprintf(w, "Push %-9q → items=%q head=%d  oldest-first=%q\n",
+			event, recent.items, recent.head, recent.Slice())
+	}
+	fmt.Fprintln(w, "  (the backing slice never grows; head moves as old events are overwritten)")
+
+	heading(w, "BOUNDED QUEUE (Error When Full)")
+	jobs := NewRingBuffer[int](2, false)
+	for _, job := range []int{1, 2, 3} {
+		if err := jobs.Push(job); err != nil {
+			fmt.Fprintf(w, "Push(%d): %v - caller must wait or drop it\n", job, err)
+			continue
+		}
+		fmt.Fprintf(w, "Push(%d): ok, len=%d/%d\n", job, jobs.Len(), jobs.Cap())
+	}
+	job, _ := jobs.Pop()
+	fmt.Fprintf(w, "Pop() → %d, freeing a slot\n", job)
+	fmt.Fprintf(w, "Push(3) again: err=%v, contents %v\n", jobs.Push(3), jobs.Slice())
+}
@@ -0,0 +1,72 @@
+package datastructures
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestRingBufferOverwrite(t *testing.T) {
+	r := NewRingBuffer[int](3, true)
+	for i := 1; i <= 7; i++ {
+		if err := r.Push(i); err != nil {
+			t.Fatalf("Push(%d) = %v, want nil in overwrite mode", i, err)
+		}
+	}
+	if r.Len() != 3 || r.Cap() != 3 {
+		t.Errorf("Len, Cap = %d, %d, want 3, 3", r.Len(), r.Cap())
+	}
+	if got, want := r.Slice(), []int{5, 6, 7}; !slices.Equal(got, want) {
+		t.Errorf("Slice() after pushing 1..7 = %v, want %v (newest 3, oldest first)", got, want)
+	}
+
+	// Pop frees a slot; the next push fills it without overwriting
+	if v, ok := r.Pop(); !ok || v != 5 {
+		t.Errorf("Pop() = %d, %v, want 5, true", v, ok)
+	}
+	r.Push(8)
+	if got, want := r.Slice(), []int{6, 7, 8}; !slices.Equal(got, want) {
+		t.Errorf("Slice() after Pop and Push(8) = %v, want %v", got, want)
+	}
+}
+
+func TestRingBufferErrorWhenFull(t *testing.T) {
+	r := NewRingBuffer[string](2, false)
+	r.Push("a")
+	r.Push("b")
+	if err := r.Push("c"); !errors.Is(err, ErrRingFull) {
+		t.Errorf("Push on a full buffer = %v, want ErrRingFull", err)
+	}
+	if got, want := r.Slice(), []string{"a", "b"}; !slices.Equal(got, want) {
+		t.Errorf("Slice() after a rejected push = %v, want %v (unchanged)", got, want)
+	}
+
+	// Wrap around: pop one, push one, and order still holds
+	if v, ok := r.Pop(); !ok || v != "a" {
+		t.Errorf("Pop() = %q, %v, want \"a\", true", v, ok)
+	}
+	if err := r.Push("c"); err != nil {
+		t.Errorf("Push after Pop = %v, want nil", err)
+	}
+	if got, want := r.Slice(), []string{"b", "c"}; !slices.Equal(got, want) {
+		t.Errorf("Slice() after wrapping = %v, want %v", got, want)
+	}
+
+	for _, want := range []string{"b", "c"} {
+		if v, ok := r.Pop(); !ok || v != want {
+			t.Errorf("Pop() = %q, %v, want %q, true", v, ok, want)
+		}
+	}
+	if v, ok := r.Pop(); ok || v != "" {
+		t.Errorf("Pop() on empty buffer = %q, %v, want \"\", false", v, ok)
+	}
+}
+
+func TestNewRingBufferPanicsOnBadCapacity(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("NewRingBuffer(0, true) did not panic")
+		}
+	}()
+	NewRingBuffer[int](0, true)
+}
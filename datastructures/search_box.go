@@ -0,0 +1,135 @@
+package datastructures
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// DEBOUNCED SEARCH-AS-YOU-TYPE
+// ============================
+// Running a filter on every keystroke wastes work: only the query the
+// user settles on matters. Debouncing waits for a quiet period first.
+// - Each keystroke restarts the timer
+// - When the timer fires, the slice is filtered once with the final query
+// - The clock (an After function) is injectable so tests can fake time
+// - A result nobody has received yet is dropped when the query changes,
+//   so a slow reader never blocks typing (or Close)
+
+// SearchBox filters a dataset after keystrokes stop for a delay
+type SearchBox struct {
+	data    []string
+	delay   time.Duration
+	after   func(time.Duration) <-chan time.Time
+	filter  func(items []string, query string) []string
+	keys    chan rune
+	results chan []string
+}
+
+// NewSearchBox starts a search box over data. Pass time.After as the
+// clock in real code, or a fake that returns a controllable channel.
+func NewSearchBox(data []string, delay time.Duration, after func(time.Duration) <-chan time.Time) *SearchBox {
+	return newSearchBox(data, delay, after, filterContains)
+}
+
+// newSearchBox lets tests swap in a filter that counts its calls
+func newSearchBox(data []string, delay time.Duration, after func(time.Duration) <-chan time.Time,
+	filter func([]string, string) []string) *SearchBox {
+	s := &SearchBox{
+		data:    data,
+		delay:   delay,
+		after:   after,
+		filter:  filter,
+		keys:    make(chan rune),
+		results: make(chan []string),
+	}
+	go s.loop()
+	return s
+}
+
+// Type sends a keystroke; '\b' deletes the last character
+func (s *SearchBox) Type(r rune) {
+	s.keys <- r
+}
+
+// Results delivers the matches each time the debounce timer fires,
+// unless more keys were typed before the matches were received
+func (s *SearchBox) Results() <-chan []string {
+	return s.results
+}
+
+// Close stops the search box and closes the Results channel
+func (s *SearchBox) Close() {
+	close(s.keys)
+}
+
+func (s *SearchBox) loop() {
+	defer close(s.results)
+
+	var query []rune
+	var timer <-chan time.Time // nil channel: blocks until a key arrives
+
+	// The send happens inside the select, so keys (and Close) are still
+	// received while a result waits. out is nil, and never ready, while
+	// there's nothing to send.
+	var out chan<- []string
+	var pending []string
+
+	for {
+		select {
+		case r, ok := <-s.keys:
+			if !ok {
+				return
+			}
+			out, pending = nil, nil // the query changed: that result is stale
+			if r == '\b' {
+				if len(query) > 0 {
+					query = query[:len(query)-1]
+				}
+			} else {
+				query = append(query, r)
+			}
+			timer = s.after(s.delay) // restart the debounce window
+		case <-timer:
+			timer = nil
+			pending = s.filter(s.data, string(query))
+			out = s.results
+		case out <- pending:
+			out, pending = nil, nil
+		}
+	}
+}
+
+// filterContains returns items containing query (case-insensitive)
+func filterContains(items []string, query string) []string {
+	query = strings.ToLower(query)
+	var matches []string
+	for _, item := range items {
+		if strings.Contains(strings.ToLower(item), query) {
+			matches = append(matches, item)
+		}
+	}
+	return matches
+}
+
+// SlicePatternDebouncedSearch demonstrates filtering once typing pauses
+func SlicePatternDebouncedSearch(w io.Writer) {
+	heading(w, "PATTERN: DEBOUNCED SEARCH")
+
+	fruits := []string{"Apple", "Apricot", "Banana", "Blueberry", "Grape", "Pineapple"}
+	box := NewSearchBox(fruits, 50*time.Millisecond, time.After)
+	defer box.Close()
+
+	// Rapid keystrokes: the filter must NOT run for "a", "ap", "app"...
+	for _, r := range "appl" {
+		box.Type(r)
+	}
+	fmt.Fprintf(w, "Typed %q quickly, waiting for the debounce...\n", "appl")
+	fmt.Fprintf(w, "Matches: %v\n", <-box.Results())
+
+	for _, r := range "\b\bri" {
+		box.Type(r)
+	}
+	fmt.Fprintf(w, "Two backspaces + \"ri\" → query %q, matches: %v\n", "apri", <-box.Results())
+}
@@ -0,0 +1,157 @@
+package datastructures
+
+import (
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeClock hands out timer channels that only fire when the test says so
+type fakeClock struct {
+	mu      sync.Mutex
+	timers  []chan time.Time
+	created chan struct{} // one signal per After call
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{created: make(chan struct{}, 100)}
+}
+
+func (c *fakeClock) After(time.Duration) <-chan time.Time {
+	ch := make(chan time.Time, 1)
+	c.mu.Lock()
+	c.timers = append(c.timers, ch)
+	c.mu.Unlock()
+	c.created <- struct{}{}
+	return ch
+}
+
+// waitTimers blocks until n timers exist, i.e. the search box has
+// handled n keystrokes
+func (c *fakeClock) waitTimers(t *testing.T, n int) {
+	t.Helper()
+	for range n {
+		select {
+		case <-c.created:
+		case <-time.After(time.Second):
+			t.Fatal("search box did not start a timer")
+		}
+	}
+}
+
+// fire makes timer i go off
+func (c *fakeClock) fire(i int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.timers[i] <- time.Time{}
+}
+
+// countingFilter wraps filterContains and records every query it runs
+type countingFilter struct {
+	mu      sync.Mutex
+	queries []string
+}
+
+func (f *countingFilter) filter(items []string, query string) []string {
+	f.mu.Lock()
+	f.queries = append(f.queries, query)
+	f.mu.Unlock()
+	return filterContains(items, query)
+}
+
+func (f *countingFilter) calls() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return slices.Clone(f.queries)
+}
+
+func receive(t *testing.T, ch <-chan []string) ([]string, bool) {
+	t.Helper()
+	select {
+	case got, ok := <-ch:
+		return got, ok
+	case <-time.After(time.Second):
+		t.Fatal("no result within 1s")
+		return nil, false
+	}
+}
+
+var searchFruits = []string{"Apple", "Apricot", "Banana", "Blueberry", "Grape", "Pineapple"}
+
+func TestSearchBoxDebounce(t *testing.T) {
+	clock := newFakeClock()
+	var f countingFilter
+	box := newSearchBox(searchFruits, time.Second, clock.After, f.filter)
+
+	for _, r := range "appl" {
+		box.Type(r)
+	}
+	clock.waitTimers(t, 4)
+	// The stale timers are no longer selected on, so firing them does nothing
+	for i := range 3 {
+		clock.fire(i)
+	}
+	clock.fire(3)
+
+	got, _ := receive(t, box.Results())
+	if want := []string{"Apple", "Pineapple"}; !slices.Equal(got, want) {
+		t.Errorf("matches = %q, want %q", got, want)
+	}
+
+	box.Close()
+	if extra, ok := receive(t, box.Results()); ok {
+		t.Errorf("unexpected extra result %q", extra)
+	}
+	if calls := f.calls(); !slices.Equal(calls, []string{"appl"}) {
+		t.Errorf("filter ran for %q, want exactly once for %q", calls, "appl")
+	}
+}
+
+func TestSearchBoxTypingWhileResultPending(t *testing.T) {
+	clock := newFakeClock()
+	box := newSearchBox(searchFruits, time.Second, clock.After, filterContains)
+	defer box.Close()
+
+	box.Type('a')
+	box.Type('p')
+	clock.waitTimers(t, 2)
+	clock.fire(1) // a result for "ap" is now pending and nobody reads it
+
+	// Typing must not block on that unread result
+	typed := make(chan struct{})
+	go func() {
+		box.Type('r')
+		close(typed)
+	}()
+	select {
+	case <-typed:
+	case <-time.After(time.Second):
+		t.Fatal("Type blocked while a result was pending")
+	}
+
+	clock.waitTimers(t, 1)
+	clock.fire(2)
+	got, _ := receive(t, box.Results())
+	if want := []string{"Apricot"}; !slices.Equal(got, want) {
+		t.Errorf("matches = %q, want %q (the stale \"ap\" result must be dropped)", got, want)
+	}
+}
+
+func TestSearchBoxCloseWithPendingResult(t *testing.T) {
+	clock := newFakeClock()
+	box := newSearchBox(searchFruits, time.Second, clock.After, filterContains)
+
+	box.Type('a')
+	clock.waitTimers(t, 1)
+	clock.fire(0)
+	box.Close()
+
+	// The loop exits instead of blocking on the unread result. A result
+	// may or may not be delivered first, but Results is then closed.
+	for {
+		if _, ok := receive(t, box.Results()); !ok {
+			return
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package datastructures
+
+import (
+	"fmt"
+	"io"
+)
+
+// SELF-TEST
+// =========
+// A smoke test for the whole tutorial: run every registered topic with
+// output discarded, and turn any panic into an error instead of a crash,
+// so one broken example doesn't hide the results of the others.
+
+// RunAllSafely runs every registered topic and returns one error per
+// topic that panicked, or nil if all of them completed
+func RunAllSafely() []error {
+	return runTopicsSafely(registry)
+}
+
+// runTopicsSafely runs each of topics in order, collecting the panics
+func runTopicsSafely(topics []Topic) []error {
+	var errs []error
+	for _, t := range topics {
+		if err := runSafely(t); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs
+}
+
+// runSafely runs a single topic, converting a panic into an error
+func runSafely(t Topic) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%s: panic: %v", t.Key, r)
+		}
+	}()
+	t.Run(io.Discard)
+	return nil
+}
+
+// selfTest runs RunAllSafely and reports the outcome to w. It returns
+// false if any topic failed.
+func selfTest(w io.Writer) bool {
+	errs := RunAllSafely()
+	for _, err := range errs {
+		fmt.Fprintf(w, "%s %v\n", failMark(w), err)
+	}
+	if len(errs) > 0 {
+		fmt.Fprintf(w, "self-test: %d of %d topics failed\n", len(errs), len(registry))
+		return false
+	}
+	fmt.Fprintf(w, "%s self-test: all %d topics ran without panicking\n", okMark(w), len(registry))
+	return true
+}
@@ -0,0 +1,43 @@
+package datastructures
+
+import (
+	"io"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestRunTopicsSafelyReportsPanics(t *testing.T) {
+	var ran []string
+	topic := func(key string, fail bool) Topic {
+		return Topic{Key: key, Run: func(io.Writer) {
+			ran = append(ran, key)
+			if fail {
+				var m map[string]int
+				m[key] = 1 // assignment to entry in nil map
+			}
+		}}
+	}
+	topics := []Topic{
+		topic("first", false),
+		topic("broken", true),
+		topic("last", false),
+	}
+
+	errs := runTopicsSafely(topics)
+	if want := []string{"first", "broken", "last"}; !slices.Equal(ran, want) {
+		t.Errorf("topics ran = %v, want %v (a panic must not stop the rest)", ran, want)
+	}
+	if len(errs) != 1 {
+		t.Fatalf("runTopicsSafely returned %d errors (%v), want 1", len(errs), errs)
+	}
+	if msg := errs[0].Error(); !strings.HasPrefix(msg, "broken: panic: ") || !strings.Contains(msg, "nil map") {
+		t.Errorf("error = %q, want it to name the topic and the panic", msg)
+	}
+}
+
+func TestRunAllSafely(t *testing.T) {
+	if errs := RunAllSafely(); len(errs) != 0 {
+		t.Errorf("RunAllSafely() = %v, want no panics", errs)
+	}
+}
@@ -0,0 +1,114 @@
+package datastructures
+
+import (
+	"fmt"
+	"io"
+	"sync"
+)
+
+// CONCURRENT SETTINGS STORE
+// =========================
+// Maps are not safe for concurrent use (see MapGotchas). A common fix is
+// to guard the map with a sync.RWMutex:
+// - RLock for reads: many readers may proceed at once
+// - Lock for writes: exclusive access
+// Watch adds change notifications per key using channels.
+
+// SettingsStore is a goroutine-safe key/value store with change subscriptions
+type SettingsStore struct {
+	mu       sync.RWMutex
+	values   map[string]any
+	watchers map[string][]chan any
+}
+
+// NewSettingsStore creates an empty store
+func NewSettingsStore() *SettingsStore {
+	return &SettingsStore{
+		values:   make(map[string]any),
+		watchers: make(map[string][]chan any),
+	}
+}
+
+// Get returns the value for key and whether it was set
+func (s *SettingsStore) Get(key string) (any, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	v, ok := s.values[key]
+	return v, ok
+}
+
+// Set stores value under key and notifies that key's watchers
+func (s *SettingsStore) Set(key string, value any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.values[key] = value
+
+	for _, ch := range s.watchers[key] {
+		select {
+		case ch <- value:
+		default:
+			// Slow subscriber: replace the stale pending value with the
+			// latest one. Only Set sends, and it holds the lock, so after
+			// draining there is always room.
+			select {
+			case <-ch:
+			default:
+			}
+			ch <- value
+		}
+	}
+}
+
+// Watch returns a channel that receives the new value each time key is Set.
+// The channel holds one pending value; a slow reader sees the latest one.
+func (s *SettingsStore) Watch(key string) <-chan any {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ch := make(chan any, 1)
+	s.watchers[key] = append(s.watchers[key], ch)
+	return ch
+}
+
+// MapPatternSettingsStore demonstrates a mutex-guarded map with watchers
+func MapPatternSettingsStore(w io.Writer) {
+	heading(w, "PATTERN: CONCURRENT SETTINGS STORE")
+
+	store := NewSettingsStore()
+	themeA := store.Watch("theme")
+	themeB := store.Watch("theme")
+	language := store.Watch("language")
+
+	// The subscribers only receive; what they saw comes back on seen and
+	// is printed here, since w is not safe for use from several goroutines
+	seen := make(chan string, 2)
+	var wg sync.WaitGroup
+	for _, sub := range []struct {
+		name string
+		ch   <-chan any
+	}{{"A", themeA}, {"B", themeB}} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			seen <- fmt.Sprintf("subscriber %s saw theme=%v", sub.name, <-sub.ch)
+		}()
+	}
+
+	store.Set("theme", "dark")
+	store.Set("fontSize", 14) // nobody watches this key
+	wg.Wait()
+	close(seen)
+	for line := range seen {
+		fmt.Fprintf(w, "  %s\n", line)
+	}
+
+	select {
+	case v := <-language:
+		fmt.Fprintf(w, "  language watcher unexpectedly got %v\n", v)
+	default:
+		fmt.Fprintln(w, "  language watcher: no notification (different key)")
+	}
+
+	theme, _ := store.Get("theme")
+	size, _ := store.Get("fontSize")
+	fmt.Fprintf(w, "Get(theme)=%v, Get(fontSize)=%v\n", theme, size)
+}
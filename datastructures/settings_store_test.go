@@ -0,0 +1,95 @@
+package datastructures
+
+import (
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Run with -race:
+//
+//	go test -race -run SettingsStore ./datastructures
+func TestSettingsStoreWatch(t *testing.T) {
+	store := NewSettingsStore()
+	subA := store.Watch("theme")
+	subB := store.Watch("theme")
+	other := store.Watch("language")
+
+	// Each subscriber reads in its own goroutine while Set runs in another
+	got := make([]any, 2)
+	var wg sync.WaitGroup
+	for i, sub := range []<-chan any{subA, subB} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case v := <-sub:
+				got[i] = v
+			case <-time.After(time.Second):
+			}
+		}()
+	}
+	go store.Set("theme", "dark")
+	wg.Wait()
+
+	for i, v := range got {
+		if v != "dark" {
+			t.Errorf("subscriber %d got %v, want \"dark\"", i, v)
+		}
+	}
+	select {
+	case v := <-other:
+		t.Errorf("language watcher notified with %v for a theme change", v)
+	default:
+	}
+	if v, ok := store.Get("theme"); !ok || v != "dark" {
+		t.Errorf("Get(theme) = %v, %v; want dark, true", v, ok)
+	}
+}
+
+func TestSettingsStoreSlowSubscriberGetsLatest(t *testing.T) {
+	store := NewSettingsStore()
+	sub := store.Watch("volume")
+	for v := 1; v <= 5; v++ {
+		store.Set("volume", v) // nobody reading yet: must not block
+	}
+	if v := <-sub; v != 5 {
+		t.Errorf("slow subscriber got %v, want the latest value 5", v)
+	}
+}
+
+func TestSettingsStoreConcurrentAccess(t *testing.T) {
+	store := NewSettingsStore()
+	sub := store.Watch("k")
+	var wg sync.WaitGroup
+	for i := range 10 {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			store.Set("k", i)
+		}()
+		go func() {
+			defer wg.Done()
+			store.Get("k")
+		}()
+	}
+	wg.Wait()
+	select {
+	case <-sub:
+	default:
+		t.Error("watcher saw none of the 10 Sets")
+	}
+}
+
+func TestMapPatternSettingsStoreOutput(t *testing.T) {
+	// strings.Builder is not goroutine-safe, so -race flags any subscriber
+	// that writes to it directly
+	var sb strings.Builder
+	MapPatternSettingsStore(&sb)
+	for _, want := range []string{"subscriber A saw theme=dark", "subscriber B saw theme=dark"} {
+		if !strings.Contains(sb.String(), want) {
+			t.Errorf("output is missing %q:\n%s", want, sb.String())
+		}
+	}
+}
@@ -0,0 +1,159 @@
+package datastructures
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// GENERIC SLICE HELPERS
+// =====================
+// Reusable versions of the loops in arrays_slices.go.
+
+// FilterMap applies f to every element of s and keeps the results for
+// which f reports true, in input order. It is Filter and Map in a single
+// pass: no intermediate slice is built between the two steps.
+func FilterMap[T, U any](s []T, f func(T) (U, bool)) []U {
+	var out []U
+	for _, v := range s {
+		if u, ok := f(v); ok {
+			out = append(out, u)
+		}
+	}
+	return out
+}
+
+// Clone returns a copy of s backed by a fresh array, so appends and
+// writes to either slice never show up in the other. A nil slice clones
+// to nil and an empty non-nil slice to an empty non-nil slice, so
+// s == nil checks behave the same on the copy.
+func Clone[T any](s []T) []T {
+	if s == nil {
+		return nil
+	}
+	return append(make([]T, 0, len(s)), s...)
+}
+
+// EqualSlices reports whether a and b have the same length and equal
+// elements in the same order. A nil slice and an empty non-nil slice
+// are equal: both hold no elements (slices.Equal makes the same choice).
+// Use a == nil when the difference matters.
+func EqualSlices[T comparable](a, b []T) bool {
+	return EqualSlicesFunc(a, b, func(x, y T) bool { return x == y })
+}
+
+// EqualSlicesFunc is EqualSlices with a custom element comparison, for
+// element types that aren't comparable (slices, maps) or need a looser
+// notion of equality (case-insensitive strings, floats within epsilon)
+func EqualSlicesFunc[T any](a, b []T, eq func(T, T) bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !eq(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Insert returns s with vals inserted before s[index]; index == len(s)
+// appends. Like s[i] itself, it panics if index is out of range: a bad
+// index is a bug in the caller, not a condition to handle at runtime.
+// When s has spare capacity the insert happens in place, so (as with
+// append) always use the returned slice.
+func Insert[T any](s []T, index int, vals ...T) []T {
+	if index < 0 || index > len(s) {
+		panic(fmt.Sprintf("Insert: index %d out of range [0:%d]", index, len(s)))
+	}
+	n := len(s)
+	s = append(s, vals...)                // grow by len(vals)
+	copy(s[index+len(vals):], s[index:n]) // shift the tail right
+	copy(s[index:], vals)
+	return s
+}
+
+// RemoveOrdered removes s[index], shifting the later elements left to
+// keep their order. O(n). It panics if index is out of range.
+func RemoveOrdered[T any](s []T, index int) []T {
+	checkRemoveIndex("RemoveOrdered", index, len(s))
+	copy(s[index:], s[index+1:])
+	var zero T
+	s[len(s)-1] = zero // don't keep a stale copy (or pointer) past the end
+	return s[:len(s)-1]
+}
+
+// RemoveUnordered removes s[index] by moving the last element into its
+// place. O(1), but the order of the remaining elements changes.
+// It panics if index is out of range.
+func RemoveUnordered[T any](s []T, index int) []T {
+	checkRemoveIndex("RemoveUnordered", index, len(s))
+	last := len(s) - 1
+	s[index] = s[last]
+	var zero T
+	s[last] = zero
+	return s[:last]
+}
+
+func checkRemoveIndex(fn string, index, n int) {
+	if index < 0 || index >= n {
+		panic(fmt.Sprintf("%s: index %d out of range [0:%d]", fn, index, n))
+	}
+}
+
+// SlicePatternFilterMap demonstrates filtering and mapping in one pass
+func SlicePatternFilterMap(w io.Writer) {
+	heading(w, "PATTERN: FILTER-MAP (one pass)")
+
+	inputs := []string{"10", "abc", "-3", "", "42", "4.5", "7"}
+
+	// Parsing is both the test and the transformation: keep what parses
+	nums := FilterMap(inputs, func(s string) (int, bool) {
+		n, err := strconv.Atoi(s)
+		return n, err == nil
+	})
+	fmt.Fprintf(w, "Inputs: %q\n", inputs)
+	fmt.Fprintf(w, "Parsed ints: %v (failures dropped, order kept)\n", nums)
+
+	// Separate Filter + Map would call Atoi twice per element,
+	// or allocate an intermediate []string of the valid inputs
+	squares := FilterMap(nums, func(n int) (int, bool) { return n * n, n > 0 })
+	fmt.Fprintf(w, "Squares of positives: %v\n", squares)
+}
+
+// Zip pairs up as[i] with bs[i]: MakePairs under its usual name. If the
+// lengths differ, the result is as long as the SHORTER input and the
+// extra elements of the longer one are dropped (like Python's zip);
+// check the lengths first if that would hide a bug.
+func Zip[A, B any](as []A, bs []B) []Pair[A, B] {
+	return MakePairs(as, bs)
+}
+
+// Unzip splits pairs back into two slices of equal length, undoing Zip
+// (apart from anything Zip truncated)
+func Unzip[A, B any](pairs []Pair[A, B]) ([]A, []B) {
+	as := make([]A, len(pairs))
+	bs := make([]B, len(pairs))
+	for i, p := range pairs {
+		as[i], bs[i] = p.First, p.Second
+	}
+	return as, bs
+}
+
+// SlicePatternZip demonstrates pairing two slices element by element
+func SlicePatternZip(w io.Writer) {
+	heading(w, "PATTERN: ZIP AND UNZIP")
+
+	names := []string{"Alice", "Bob", "Carol"}
+	scores := []int{92, 78, 85}
+	for _, p := range Zip(names, scores) {
+		fmt.Fprintf(w, "  %-6s %d\n", p.First, p.Second)
+	}
+
+	// Different lengths: the extra "Dave" has no score and is dropped
+	pairs := Zip(append(names, "Dave"), scores)
+	fmt.Fprintf(w, "Zip of 4 names and 3 scores: %d pairs (extra names dropped)\n", len(pairs))
+
+	gotNames, gotScores := Unzip(pairs)
+	fmt.Fprintf(w, "Unzip: %q and %v\n", gotNames, gotScores)
+}
@@ -0,0 +1,206 @@
+package datastructures
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestFilterMap(t *testing.T) {
+	parse := func(s string) (int, bool) {
+		n, err := strconv.Atoi(s)
+		return n, err == nil
+	}
+	tests := []struct {
+		in   []string
+		want []int
+	}{
+		{[]string{"3", "x", "1", "", "2", "4.5", "-7"}, []int{3, 1, 2, -7}},
+		{[]string{"10", "20"}, []int{10, 20}},
+		{[]string{"a", "b"}, nil},
+		{nil, nil},
+	}
+	for _, tt := range tests {
+		if got := FilterMap(tt.in, parse); !slices.Equal(got, tt.want) {
+			t.Errorf("FilterMap(%q, Atoi) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+
+	// f runs exactly once per element, in order
+	var seen []string
+	FilterMap([]string{"a", "1", "b"}, func(s string) (int, bool) {
+		seen = append(seen, s)
+		return parse(s)
+	})
+	if want := []string{"a", "1", "b"}; !slices.Equal(seen, want) {
+		t.Errorf("FilterMap called f with %q, want %q", seen, want)
+	}
+}
+
+func TestClone(t *testing.T) {
+	original := make([]int, 3, 10) // spare capacity an alias would write into
+	copy(original, []int{1, 2, 3})
+
+	clone := Clone(original)
+	if &clone[0] == &original[0] {
+		t.Fatal("Clone shares the backing array with the original")
+	}
+	clone = append(clone, 4)
+	clone[0] = 99
+	if got := original[:cap(original)][:4]; got[0] != 1 || got[3] != 0 {
+		t.Errorf("writes to the clone reached the original: %v", got)
+	}
+
+	if got := Clone([]int(nil)); got != nil {
+		t.Errorf("Clone(nil) = %#v, want nil", got)
+	}
+	if got := Clone([]int{}); got == nil || len(got) != 0 {
+		t.Errorf("Clone([]int{}) = %#v, want empty non-nil", got)
+	}
+}
+
+func TestInsert(t *testing.T) {
+	tests := []struct {
+		name  string
+		index int
+		vals  []int
+		want  []int
+	}{
+		{"front", 0, []int{0}, []int{0, 1, 2, 3}},
+		{"middle", 1, []int{8, 9}, []int{1, 8, 9, 2, 3}},
+		{"end appends", 3, []int{4}, []int{1, 2, 3, 4}},
+		{"no values", 2, nil, []int{1, 2, 3}},
+	}
+	for _, tt := range tests {
+		got := Insert([]int{1, 2, 3}, tt.index, tt.vals...)
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%s: Insert at %d = %v, want %v", tt.name, tt.index, got, tt.want)
+		}
+	}
+
+	// In place, with spare capacity
+	s := make([]int, 3, 10)
+	copy(s, []int{1, 2, 3})
+	if got := Insert(s, 1, 7); !slices.Equal(got, []int{1, 7, 2, 3}) {
+		t.Errorf("in-place Insert = %v, want [1 7 2 3]", got)
+	}
+
+	for _, index := range []int{-1, 4} {
+		assertPanics(t, func() { Insert([]int{1, 2, 3}, index, 0) })
+	}
+}
+
+func TestRemove(t *testing.T) {
+	for _, tt := range []struct {
+		index     int
+		ordered   []int
+		unordered []int
+	}{
+		{0, []int{2, 3, 4}, []int{4, 2, 3}},
+		{1, []int{1, 3, 4}, []int{1, 4, 3}},
+		{3, []int{1, 2, 3}, []int{1, 2, 3}}, // last element
+	} {
+		if got := RemoveOrdered([]int{1, 2, 3, 4}, tt.index); !slices.Equal(got, tt.ordered) {
+			t.Errorf("RemoveOrdered(%d) = %v, want %v", tt.index, got, tt.ordered)
+		}
+		if got := RemoveUnordered([]int{1, 2, 3, 4}, tt.index); !slices.Equal(got, tt.unordered) {
+			t.Errorf("RemoveUnordered(%d) = %v, want %v", tt.index, got, tt.unordered)
+		}
+	}
+
+	if got := RemoveOrdered([]int{5}, 0); len(got) != 0 {
+		t.Errorf("RemoveOrdered on one element = %v, want empty", got)
+	}
+	for _, index := range []int{-1, 4} {
+		assertPanics(t, func() { RemoveOrdered([]int{1, 2, 3, 4}, index) })
+		assertPanics(t, func() { RemoveUnordered([]int{1, 2, 3, 4}, index) })
+	}
+	assertPanics(t, func() { RemoveOrdered([]int{}, 0) })
+}
+
+func assertPanics(t *testing.T, f func()) {
+	t.Helper()
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic for an out-of-range index")
+		}
+	}()
+	f()
+}
+
+func TestEqualSlices(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []int
+		want bool
+	}{
+		{"both nil", nil, nil, true},
+		{"nil vs empty", nil, []int{}, true},
+		{"both empty", []int{}, []int{}, true},
+		{"equal content", []int{1, 2, 3}, []int{1, 2, 3}, true},
+		{"different lengths", []int{1, 2, 3}, []int{1, 2}, false},
+		{"nil vs non-empty", nil, []int{1}, false},
+		{"same length, different element", []int{1, 2, 3}, []int{1, 9, 3}, false},
+		{"same elements, different order", []int{1, 2}, []int{2, 1}, false},
+	}
+	for _, tt := range tests {
+		if got := EqualSlices(tt.a, tt.b); got != tt.want {
+			t.Errorf("%s: EqualSlices(%v, %v) = %v, want %v", tt.name, tt.a, tt.b, got, tt.want)
+		}
+		if got := EqualSlices(tt.b, tt.a); got != tt.want {
+			t.Errorf("%s: EqualSlices is not symmetric", tt.name)
+		}
+	}
+}
+
+func TestEqualSlicesFunc(t *testing.T) {
+	// [][]int isn't comparable, so only the Func variant accepts it
+	a := [][]int{{1, 2}, {3}}
+	b := [][]int{{1, 2}, {3}}
+	if !EqualSlicesFunc(a, b, slices.Equal[[]int]) {
+		t.Error("EqualSlicesFunc on equal nested slices = false, want true")
+	}
+	b[1] = []int{4}
+	if EqualSlicesFunc(a, b, slices.Equal[[]int]) {
+		t.Error("EqualSlicesFunc on different nested slices = true, want false")
+	}
+	if !EqualSlicesFunc([]string{"Go"}, []string{"GO"}, strings.EqualFold) {
+		t.Error("EqualSlicesFunc with EqualFold = false, want true")
+	}
+	if EqualSlicesFunc(nil, []string{"x"}, strings.EqualFold) {
+		t.Error("EqualSlicesFunc with different lengths = true, want false")
+	}
+}
+
+func TestZipUnzip(t *testing.T) {
+	tests := []struct {
+		name   string
+		as     []string
+		bs     []int
+		wantAs []string
+		wantBs []int
+	}{
+		{"equal lengths", []string{"a", "b", "c"}, []int{1, 2, 3}, []string{"a", "b", "c"}, []int{1, 2, 3}},
+		{"first longer", []string{"a", "b", "c"}, []int{1}, []string{"a"}, []int{1}},
+		{"second longer", []string{"a"}, []int{1, 2, 3}, []string{"a"}, []int{1}},
+		{"one empty", nil, []int{1, 2}, []string{}, []int{}},
+		{"both empty", nil, nil, []string{}, []int{}},
+	}
+	for _, tt := range tests {
+		pairs := Zip(tt.as, tt.bs)
+		if len(pairs) != len(tt.wantAs) {
+			t.Errorf("%s: Zip returned %d pairs, want %d", tt.name, len(pairs), len(tt.wantAs))
+			continue
+		}
+		for i, p := range pairs {
+			if p.First != tt.as[i] || p.Second != tt.bs[i] {
+				t.Errorf("%s: pair %d = %+v, want {%q %d}", tt.name, i, p, tt.as[i], tt.bs[i])
+			}
+		}
+		gotAs, gotBs := Unzip(pairs)
+		if !slices.Equal(gotAs, tt.wantAs) || !slices.Equal(gotBs, tt.wantBs) {
+			t.Errorf("%s: Unzip = %q, %v; want %q, %v", tt.name, gotAs, gotBs, tt.wantAs, tt.wantBs)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package datastructures
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"time"
+)
+
+// SLIDING WINDOW COUNTER (Sliding Log)
+// ====================================
+// "How many requests in the last minute?" - keep a log of timestamps in
+// a slice, oldest first, and drop entries once they fall out of the window.
+// - Record appends to the end (timestamps arrive in order)
+// - Eviction re-slices from the front, like a queue
+// - now is a parameter, not time.Now(), so behavior is reproducible
+
+// SlidingWindowCounter counts events within a trailing time window
+type SlidingWindowCounter struct {
+	window time.Duration
+	events []time.Time // sorted, oldest first
+}
+
+// NewSlidingWindowCounter keeps events for at most window
+func NewSlidingWindowCounter(window time.Duration) *SlidingWindowCounter {
+	return &SlidingWindowCounter{window: window}
+}
+
+// Record logs an event at time t. Events are expected in time order.
+func (c *SlidingWindowCounter) Record(t time.Time) {
+	c.events = append(c.events, t)
+}
+
+// CountWithin returns how many events happened in (now-d, now].
+// Events older than the counter's window are evicted first, so d
+// larger than the window is capped at the window.
+func (c *SlidingWindowCounter) CountWithin(d time.Duration, now time.Time) int {
+	c.evict(now)
+	cutoff := now.Add(-d)
+	// First index whose event is strictly after the cutoff
+	i := sort.Search(len(c.events), func(i int) bool {
+		return c.events[i].After(cutoff)
+	})
+	return len(c.events) - i
+}
+
+// Len returns the number of events still retained
+func (c *SlidingWindowCounter) Len() int {
+	return len(c.events)
+}
+
+func (c *SlidingWindowCounter) evict(now time.Time) {
+	cutoff := now.Add(-c.window)
+	i := 0
+	for i < len(c.events) && !c.events[i].After(cutoff) {
+		i++
+	}
+	c.events = c.events[i:]
+}
+
+// SlicePatternSlidingWindow demonstrates counting events in a time window
+func SlicePatternSlidingWindow(w io.Writer) {
+	heading(w, "PATTERN: SLIDING WINDOW COUNTER")
+
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	at := func(sec int) time.Time { return start.Add(time.Duration(sec) * time.Second) }
+
+	counter := NewSlidingWindowCounter(time.Minute)
+	for _, sec := range []int{0, 10, 20, 45, 50, 55} {
+		counter.Record(at(sec))
+	}
+	fmt.Fprintln(w, "Recorded events at t=0s, 10s, 20s, 45s, 50s, 55s (window: 1m)")
+
+	now := at(60)
+	fmt.Fprintf(w, "At t=60s: last 10s=%d, last 30s=%d, last 1m=%d\n",
+		counter.CountWithin(10*time.Second, now),
+		counter.CountWithin(30*time.Second, now),
+		counter.CountWithin(time.Minute, now))
+
+	now = at(90)
+	fmt.Fprintf(w, "At t=90s: last 1m=%d (events at 0s-20s evicted, %d retained)\n",
+		counter.CountWithin(time.Minute, now), counter.Len())
+}
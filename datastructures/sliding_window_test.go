@@ -0,0 +1,73 @@
+package datastructures
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSlidingWindowCounterCountWithin(t *testing.T) {
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	at := func(sec int) time.Time { return start.Add(time.Duration(sec) * time.Second) }
+
+	c := NewSlidingWindowCounter(time.Minute)
+	for _, sec := range []int{0, 10, 20, 45, 50, 55} {
+		c.Record(at(sec))
+	}
+
+	now := at(60)
+	tests := []struct {
+		d    time.Duration
+		want int
+	}{
+		{0, 0},
+		{5 * time.Second, 0},  // 55 is exactly on the cutoff: excluded
+		{6 * time.Second, 1},  // 55
+		{10 * time.Second, 1}, // 55 (50 on the cutoff)
+		{11 * time.Second, 2},
+		{30 * time.Second, 3},
+		{time.Minute, 5}, // 0 is exactly on the window edge: evicted
+		{time.Hour, 5},   // capped at the window
+	}
+	for _, tt := range tests {
+		if got := c.CountWithin(tt.d, now); got != tt.want {
+			t.Errorf("CountWithin(%v, t=60s) = %d, want %d", tt.d, got, tt.want)
+		}
+	}
+}
+
+func TestSlidingWindowCounterEvictsStale(t *testing.T) {
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	at := func(sec int) time.Time { return start.Add(time.Duration(sec) * time.Second) }
+
+	c := NewSlidingWindowCounter(time.Minute)
+	for _, sec := range []int{0, 10, 20, 45, 50, 55} {
+		c.Record(at(sec))
+	}
+	if got := c.Len(); got != 6 {
+		t.Fatalf("Len() before any count = %d, want 6", got)
+	}
+
+	steps := []struct {
+		sec     int
+		wantCnt int
+		wantLen int
+	}{
+		{60, 5, 5},  // 0s drops out
+		{90, 3, 3},  // 10s and 20s drop out
+		{115, 0, 0}, // everything is older than a minute
+	}
+	for _, s := range steps {
+		if got := c.CountWithin(time.Minute, at(s.sec)); got != s.wantCnt {
+			t.Errorf("CountWithin(1m, t=%ds) = %d, want %d", s.sec, got, s.wantCnt)
+		}
+		if got := c.Len(); got != s.wantLen {
+			t.Errorf("Len() at t=%ds = %d, want %d", s.sec, got, s.wantLen)
+		}
+	}
+
+	// The emptied counter keeps working
+	c.Record(at(120))
+	if got := c.CountWithin(time.Minute, at(130)); got != 1 {
+		t.Errorf("CountWithin(1m, t=130s) after a new event = %d, want 1", got)
+	}
+}
@@ -0,0 +1,46 @@
+package datastructures
+
+import (
+	"fmt"
+	"testing"
+)
+
+// TestSquare is the table-driven test that StructPatternAnonymous prints.
+// Each row becomes a named subtest, so a failure reports which case
+// broke, and one case can be run on its own:
+//
+//	go test -run 'TestSquare/negative' -v ./datastructures
+func TestSquare(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    int
+		expected int
+	}{
+		{"zero", 0, 0},
+		{"one", 1, 1},
+		{"positive", 5, 25},
+		{"negative", -3, 9},
+		{"large", 1 << 15, 1 << 30},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Square(tt.input); got != tt.expected {
+				t.Errorf("Square(%d) = %d, want %d", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+// Subtests can also be named from their inputs and run in parallel;
+// n is per-iteration since Go 1.22, so capturing it is safe
+func TestSquareSymmetric(t *testing.T) {
+	for _, n := range []int{1, 2, 7, 100} {
+		t.Run(fmt.Sprint(n), func(t *testing.T) {
+			t.Parallel()
+			if Square(n) != Square(-n) {
+				t.Errorf("Square(%d) = %d but Square(%d) = %d", n, Square(n), -n, Square(-n))
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package datastructures
+
+import (
+	"fmt"
+	"io"
+)
+
+// STACKS AND QUEUES
+// =================
+// Go has no built-in stack or queue: a slice does both jobs.
+// - Stack: LIFO (last in, first out) - push/pop at the END of the slice
+// - Queue: FIFO (first in, first out) - push at the end, pop from the FRONT
+// - Pop/Dequeue use the comma-ok idiom instead of panicking when empty
+
+// Stack is a generic LIFO stack backed by a slice
+type Stack[T any] struct {
+	items []T
+}
+
+// Push adds v to the top of the stack
+func (s *Stack[T]) Push(v T) {
+	s.items = append(s.items, v)
+}
+
+// Pop removes and returns the top element; ok is false if the stack is empty
+func (s *Stack[T]) Pop() (v T, ok bool) {
+	if len(s.items) == 0 {
+		return v, false // v is the zero value of T
+	}
+	v = s.items[len(s.items)-1]
+	s.items = s.items[:len(s.items)-1]
+	return v, true
+}
+
+// Peek returns the top element without removing it
+func (s *Stack[T]) Peek() (v T, ok bool) {
+	if len(s.items) == 0 {
+		return v, false
+	}
+	return s.items[len(s.items)-1], true
+}
+
+// Len returns the number of elements on the stack
+func (s *Stack[T]) Len() int {
+	return len(s.items)
+}
+
+// Queue is a generic FIFO queue backed by a slice
+type Queue[T any] struct {
+	items []T
+}
+
+// Enqueue adds v to the back of the queue
+func (q *Queue[T]) Enqueue(v T) {
+	q.items = append(q.items, v)
+}
+
+// Dequeue removes and returns the front element; ok is false if the queue is empty
+func (q *Queue[T]) Dequeue() (v T, ok bool) {
+	if len(q.items) == 0 {
+		return v, false
+	}
+	v = q.items[0]
+	var zero T
+	q.items[0] = zero // drop the reference so it can be garbage collected
+	q.items = q.items[1:]
+	return v, true
+}
+
+// Len returns the number of elements in the queue
+func (q *Queue[T]) Len() int {
+	return len(q.items)
+}
+
+// RunStackQueue runs the stack and queue examples
+func RunStackQueue(w io.Writer) {
+	topicBanner("STACKS AND QUEUES IN GO", w)
+
+	heading(w, "STACK (LIFO)")
+	var stack Stack[string]
+	for _, page := range []string{"home", "products", "cart"} {
+		stack.Push(page)
+		fmt.Fprintf(w, "Push(%q) → len=%d\n", page, stack.Len())
+	}
+	if top, ok := stack.Peek(); ok {
+		fmt.Fprintf(w, "Peek: %q (still len=%d)\n", top, stack.Len())
+	}
+	for stack.Len() > 0 {
+		page, _ := stack.Pop()
+		fmt.Fprintf(w, "Pop: %q\n", page)
+	}
+	if _, ok := stack.Pop(); !ok {
+		fmt.Fprintln(w, "Pop on empty stack: ok=false (no panic)")
+	}
+
+	heading(w, "QUEUE (FIFO)")
+	var queue Queue[int]
+	for _, job := range []int{101, 102, 103} {
+		queue.Enqueue(job)
+		fmt.Fprintf(w, "Enqueue(%d) → len=%d\n", job, queue.Len())
+	}
+	for queue.Len() > 0 {
+		job, _ := queue.Dequeue()
+		fmt.Fprintf(w, "Dequeue: %d\n", job)
+	}
+	if job, ok := queue.Dequeue(); !ok {
+		fmt.Fprintf(w, "Dequeue on empty queue: %d, ok=false (zero value)\n", job)
+	}
+
+	fmt.Fprintln(w, "\nNote: q.items[1:] keeps the old backing array; append")
+	fmt.Fprintln(w, "eventually reallocates, so memory is reclaimed over time.")
+
+	BlockingQueueExample(w)
+	ChannelFirstLast(w)
+}
@@ -0,0 +1,60 @@
+package datastructures
+
+import "testing"
+
+func TestStackEmpty(t *testing.T) {
+	var s Stack[string]
+	if v, ok := s.Pop(); ok || v != "" {
+		t.Errorf("Pop on empty stack = %q, %v; want \"\", false", v, ok)
+	}
+	if v, ok := s.Peek(); ok || v != "" {
+		t.Errorf("Peek on empty stack = %q, %v; want \"\", false", v, ok)
+	}
+
+	// Emptied again after use
+	s.Push("a")
+	s.Pop()
+	if _, ok := s.Pop(); ok || s.Len() != 0 {
+		t.Errorf("Pop after draining: ok=%v, len=%d; want false, 0", ok, s.Len())
+	}
+}
+
+func TestStackLIFO(t *testing.T) {
+	var s Stack[int]
+	for i := 1; i <= 3; i++ {
+		s.Push(i)
+	}
+	if top, _ := s.Peek(); top != 3 || s.Len() != 3 {
+		t.Errorf("Peek = %d with len %d, want 3 with len 3", top, s.Len())
+	}
+	for want := 3; want >= 1; want-- {
+		if got, ok := s.Pop(); !ok || got != want {
+			t.Errorf("Pop = %d, %v; want %d, true", got, ok, want)
+		}
+	}
+}
+
+func TestQueueEmpty(t *testing.T) {
+	var q Queue[int]
+	if v, ok := q.Dequeue(); ok || v != 0 {
+		t.Errorf("Dequeue on empty queue = %d, %v; want 0, false", v, ok)
+	}
+
+	q.Enqueue(1)
+	q.Dequeue()
+	if _, ok := q.Dequeue(); ok || q.Len() != 0 {
+		t.Errorf("Dequeue after draining: ok=%v, len=%d; want false, 0", ok, q.Len())
+	}
+}
+
+func TestQueueFIFO(t *testing.T) {
+	var q Queue[int]
+	for i := 1; i <= 3; i++ {
+		q.Enqueue(i)
+	}
+	for want := 1; want <= 3; want++ {
+		if got, ok := q.Dequeue(); !ok || got != want {
+			t.Errorf("Dequeue = %d, %v; want %d, true", got, ok, want)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package datastructures
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// ENUMS IN JSON
+// =============
+// An iota enum marshals as its number by default: {"status":2}. That's
+// fragile (reordering the const block changes the meaning of stored
+// data) and unreadable. Implementing json.Marshaler / json.Unmarshaler
+// makes it travel as a string instead: {"status":"pending"}
+// - MarshalJSON uses a value receiver, so Status and *Status both work
+// - UnmarshalJSON needs a pointer receiver to modify the value
+// - Unknown strings are an error, not a silent zero value
+
+// Status is the state of a task
+type Status int
+
+const (
+	Active Status = iota
+	Inactive
+	Pending
+)
+
+var statusNames = [...]string{"active", "inactive", "pending"}
+
+// String implements fmt.Stringer
+func (s Status) String() string {
+	if s < 0 || int(s) >= len(statusNames) {
+		return fmt.Sprintf("Status(%d)", int(s))
+	}
+	return statusNames[s]
+}
+
+// ParseStatus is the inverse of String
+func ParseStatus(name string) (Status, error) {
+	for i, n := range statusNames {
+		if n == name {
+			return Status(i), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown status %q", name)
+}
+
+// MarshalJSON encodes s as a JSON string like "active"
+func (s Status) MarshalJSON() ([]byte, error) {
+	if s < 0 || int(s) >= len(statusNames) {
+		return nil, fmt.Errorf("cannot marshal invalid status %d", int(s))
+	}
+	return json.Marshal(s.String())
+}
+
+// UnmarshalJSON decodes a JSON string like "active" into s
+func (s *Status) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return fmt.Errorf("status must be a string: %w", err)
+	}
+	parsed, err := ParseStatus(name)
+	if err != nil {
+		return err
+	}
+	*s = parsed
+	return nil
+}
+
+// Task is a struct with a Status field
+type Task struct {
+	Title  string `json:"title"`
+	Status Status `json:"status"`
+}
+
+// StatusEnumJSON demonstrates an enum that serializes as a string
+func StatusEnumJSON(w io.Writer) {
+	heading(w, "ENUMS AS JSON STRINGS")
+
+	tasks := []Task{{"Write docs", Active}, {"Old ticket", Inactive}, {"Review PR", Pending}}
+	data, err := json.Marshal(tasks)
+	if err != nil {
+		fmt.Fprintf(w, "Marshal error: %v\n", err)
+		return
+	}
+	fmt.Fprintf(w, "Marshaled: %s\n", data)
+
+	var decoded []Task
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		fmt.Fprintf(w, "Unmarshal error: %v\n", err)
+		return
+	}
+	fmt.Fprintf(w, "Unmarshaled: %+v\n", decoded)
+
+	for _, input := range []string{
+		`{"title":"Typo","status":"actve"}`,
+		`{"title":"Number","status":1}`,
+	} {
+		var t Task
+		err := json.Unmarshal([]byte(input), &t)
+		fmt.Fprintf(w, "%s\n  %s %v\n", input, failMark(w), err)
+	}
+
+	_, err = json.Marshal(Task{"Bad", Status(7)})
+	fmt.Fprintf(w, "Marshal Status(7): %s %v\n", failMark(w), err)
+}
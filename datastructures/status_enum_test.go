@@ -0,0 +1,50 @@
+package datastructures
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestStatusJSONRoundTrip(t *testing.T) {
+	for _, s := range []Status{Active, Inactive, Pending} {
+		in := Task{Title: "t", Status: s}
+		data, err := json.Marshal(in)
+		if err != nil {
+			t.Fatalf("Marshal(%v): %v", s, err)
+		}
+		want := `{"title":"t","status":"` + s.String() + `"}`
+		if string(data) != want {
+			t.Errorf("Marshal(%v) = %s, want %s", s, data, want)
+		}
+
+		var out Task
+		if err := json.Unmarshal(data, &out); err != nil {
+			t.Fatalf("Unmarshal(%s): %v", data, err)
+		}
+		if out != in {
+			t.Errorf("round trip: got %+v, want %+v", out, in)
+		}
+	}
+}
+
+func TestStatusUnmarshalErrors(t *testing.T) {
+	for _, input := range []string{
+		`{"status":"archived"}`,
+		`{"status":"Active"}`,
+		`{"status":1}`,
+	} {
+		var task Task
+		if err := json.Unmarshal([]byte(input), &task); err == nil {
+			t.Errorf("Unmarshal(%s) = %+v, want error", input, task)
+		}
+	}
+}
+
+func TestStatusMarshalInvalid(t *testing.T) {
+	if data, err := json.Marshal(Status(42)); err == nil {
+		t.Errorf("Marshal(Status(42)) = %s, want error", data)
+	}
+	if got := Status(42).String(); got != "Status(42)" {
+		t.Errorf("Status(42).String() = %q", got)
+	}
+}
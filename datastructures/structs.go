@@ -1,8 +1,12 @@
-package main
+package datastructures
 
 import (
+	"encoding/json"
 	"fmt"
-	"strings"
+	"io"
+	"math"
+	"reflect"
+	"time"
 )
 
 // STRUCTS
@@ -14,8 +18,8 @@ import (
 
 // Person is a basic struct with exported (public) fields
 type Person struct {
-	Name string
-	Age  int
+	Name string `validate:"required"`
+	Age  int    `validate:"min=0,max=150"`
 	City string
 }
 
@@ -37,13 +41,28 @@ type Rectangle struct {
 	BottomRight Point
 }
 
+// PersonWithHobbies has a slice field, so it is NOT comparable with ==
+type PersonWithHobbies struct {
+	Name    string
+	Hobbies []string // Slices are not comparable
+}
+
+// User demonstrates struct tags (commonly used with encoding packages)
+type User struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	Email     string `json:"email,omitempty"` // Omit if empty
+	Password  string `json:"-"`               // Never serialize
+	CreatedAt string `json:"created_at"`
+}
+
 // StructBasics demonstrates fundamental struct concepts
-func StructBasics() {
-	fmt.Println("\n=== STRUCT BASICS ===")
+func StructBasics(w io.Writer) {
+	heading(w, "STRUCT BASICS")
 
 	// Zero value initialization (all fields get zero values)
 	var p1 Person
-	fmt.Printf("Zero value: %+v\n", p1)
+	fmt.Fprintf(w, "Zero value: %+v\n", plainPerson(p1))
 
 	// Struct literal with field names (recommended - clear and order-independent)
 	p2 := Person{
@@ -51,85 +70,197 @@ func StructBasics() {
 		Age:  30,
 		City: "NYC",
 	}
-	fmt.Printf("With field names: %+v\n", p2)
+	fmt.Fprintf(w, "With field names: %+v\n", plainPerson(p2))
 
-	// Struct literal without field names (must match order, not recommended)
+	// Struct literal without field names (must match order, not recommended -
+	// see StructLiteralPitfall for how it breaks)
 	p3 := Person{"Bob", 25, "LA"}
-	fmt.Printf("Without field names: %+v\n", p3)
+	fmt.Fprintf(w, "Without field names: %+v\n", plainPerson(p3))
 
 	// Partial initialization (unspecified fields get zero values)
 	p4 := Person{Name: "Charlie", Age: 35}
-	fmt.Printf("Partial init: %+v\n", p4)
+	fmt.Fprintf(w, "Partial init: %+v\n", plainPerson(p4))
 
 	// Accessing fields
-	fmt.Printf("\nAccessing fields:\n")
-	fmt.Printf("  Name: %s\n", p2.Name)
-	fmt.Printf("  Age: %d\n", p2.Age)
+	fmt.Fprintf(w, "\nAccessing fields:\n")
+	fmt.Fprintf(w, "  Name: %s\n", p2.Name)
+	fmt.Fprintf(w, "  Age: %d\n", p2.Age)
 
 	// Modifying fields
 	p2.Age = 31
-	fmt.Printf("After modification: %+v\n", p2)
+	fmt.Fprintf(w, "After modification: %+v\n", plainPerson(p2))
+}
+
+// StructLiteralPitfall shows why positional struct literals are fragile
+func StructLiteralPitfall(w io.Writer) {
+	heading(w, "PITFALL: POSITIONAL STRUCT LITERALS")
+
+	// Version 1 of a type, and code written against it
+	type AddressV1 struct {
+		Street string
+		City   string
+	}
+	v1 := AddressV1{"1 Main St", "Springfield"}
+	fmt.Fprintf(w, "v1: %+v\n", v1)
+
+	// Later someone reorders the fields (say, to group them differently).
+	// The same positional literal still compiles, because both fields are
+	// strings - but the values silently land in the wrong fields.
+	type AddressV2 struct {
+		City   string
+		Street string
+	}
+	v2 := AddressV2{"1 Main St", "Springfield"}
+	fmt.Fprintf(w, "v2 (fields reordered): %+v  <- City is now the street!\n", v2)
+
+	// Adding a field breaks positional literals outright:
+	type AddressV3 struct {
+		Street string
+		City   string
+		Zip    string
+	}
+	// v3 := AddressV3{"1 Main St", "Springfield"} // compile error: too few values in struct literal
+	fmt.Fprintln(w, "v3 (field added): AddressV3{\"1 Main St\", \"Springfield\"} doesn't compile")
+
+	// Named fields survive both changes: order doesn't matter, and new
+	// fields just get their zero value
+	named := AddressV3{Street: "1 Main St", City: "Springfield"}
+	fmt.Fprintf(w, "Named fields with v3: %+v\n", named)
+	fmt.Fprintln(w, "  → Use field names for any struct that might change (go vet's")
+	fmt.Fprintln(w, "    composites check flags unkeyed literals of imported structs)")
+}
+
+// String implements fmt.Stringer, so Println and %v print "Alice (30, NYC)"
+func (p Person) String() string {
+	return fmt.Sprintf("%s (%d, %s)", p.Name, p.Age, p.City)
+}
+
+// plainPerson has Person's fields but none of its methods, so %+v
+// prints {Name:Alice Age:30 City:NYC} instead of calling String
+type plainPerson Person
+
+// plainEmployee does the same for Employee. Converting Employee itself
+// isn't enough: the embedded Person would still promote String.
+type plainEmployee struct {
+	Person     plainPerson
+	EmployeeID int
+	Department string
+}
+
+// plainEmp converts e for printing with %+v
+func plainEmp(e Employee) plainEmployee {
+	return plainEmployee{Person: plainPerson(e.Person), EmployeeID: e.EmployeeID, Department: e.Department}
+}
+
+// StructStringer demonstrates how fmt uses a String() method
+func StructStringer(w io.Writer) {
+	heading(w, "STRUCT STRINGER (fmt.Stringer)")
+
+	person := Person{Name: "Alice", Age: 30, City: "NYC"}
+
+	// Any type with a String() string method satisfies fmt.Stringer
+	fmt.Fprintln(w, "Before String(), Println showed:", plainPerson(person))
+	fmt.Fprintln(w, "After String(), Println shows: ", person)
+	fmt.Fprintf(w, "%%v: %v, %%s: %s\n", person, person)
+
+	// %+v ALSO calls String() - field names only appear without it.
+	// Converting to a type with the same fields but no methods bypasses it;
+	// the other struct demos print plainPerson(p) for that reason.
+	fmt.Fprintf(w, "%%+v: %+v\n", person)
+	fmt.Fprintf(w, "%%+v on plainPerson(person): %+v\n", plainPerson(person))
+
+	// %#v uses GoString(), not String(), so it always shows the fields
+	fmt.Fprintf(w, "%%#v: %#v\n", person)
+
+	// Methods of embedded structs are promoted: Employee is a Stringer too!
+	emp := Employee{Person: person, EmployeeID: 7, Department: "Sales"}
+	fmt.Fprintf(w, "Employee with %%v: %v (promoted Person.String hides EmployeeID)\n", emp)
+	fmt.Fprintf(w, "plainEmp(emp) with %%+v: %+v\n", plainEmp(emp))
 }
 
 // StructPointers demonstrates working with struct pointers
-func StructPointers() {
-	fmt.Println("\n=== STRUCT POINTERS ===")
+func StructPointers(w io.Writer) {
+	heading(w, "STRUCT POINTERS")
 
 	// Create struct
 	p1 := Person{Name: "Alice", Age: 30}
 
 	// Get pointer to struct
 	p2 := &p1
-	fmt.Printf("Original: %+v\n", p1)
-	fmt.Printf("Pointer: %p, Value: %+v\n", p2, *p2)
+	fmt.Fprintf(w, "Original: %+v\n", plainPerson(p1))
+	fmt.Fprintf(w, "Pointer: %p, Value: %+v\n", p2, plainPerson(*p2))
 
 	// Go automatically dereferences pointers to structs
 	// These are equivalent:
 	(*p2).Age = 31 // Explicit dereference
 	p2.Age = 32    // Automatic dereference (preferred)
-	fmt.Printf("After modification via pointer: %+v\n", p1)
+	fmt.Fprintf(w, "After modification via pointer: %+v\n", plainPerson(p1))
 
 	// Creating struct with new() - returns pointer to zeroed struct
 	p3 := new(Person)
-	fmt.Printf("Created with new(): %p, Value: %+v\n", p3, *p3)
+	fmt.Fprintf(w, "Created with new(): %p, Value: %+v\n", p3, plainPerson(*p3))
 	p3.Name = "Bob" // Can access fields directly
-	fmt.Printf("After setting fields: %+v\n", *p3)
+	fmt.Fprintf(w, "After setting fields: %+v\n", plainPerson(*p3))
 
 	// Common pattern: pointer to struct literal
 	p4 := &Person{
 		Name: "Charlie",
 		Age:  35,
 	}
-	fmt.Printf("Pointer to literal: %+v\n", *p4)
+	fmt.Fprintf(w, "Pointer to literal: %+v\n", plainPerson(*p4))
 }
 
 // StructComparison demonstrates struct comparison
-func StructComparison() {
-	fmt.Println("\n=== STRUCT COMPARISON ===")
+func StructComparison(w io.Writer) {
+	heading(w, "STRUCT COMPARISON")
 
 	p1 := Person{Name: "Alice", Age: 30, City: "NYC"}
 	p2 := Person{Name: "Alice", Age: 30, City: "NYC"}
 	p3 := Person{Name: "Bob", Age: 25, City: "LA"}
 
 	// Structs are comparable if all fields are comparable
-	fmt.Printf("p1 == p2: %v\n", p1 == p2)
-	fmt.Printf("p1 == p3: %v\n", p1 == p3)
-
-	// Struct with slice (not comparable)
-	type PersonWithHobbies struct {
-		Name    string
-		Hobbies []string // Slices are not comparable
-	}
+	fmt.Fprintf(w, "p1 == p2: %v\n", p1 == p2)
+	fmt.Fprintf(w, "p1 == p3: %v\n", p1 == p3)
 
+	// Struct with slice (not comparable) - see PersonWithHobbies
 	// ph1 := PersonWithHobbies{Name: "Alice", Hobbies: []string{"reading"}}
 	// ph2 := PersonWithHobbies{Name: "Alice", Hobbies: []string{"reading"}}
-	// fmt.Println(ph1 == ph2) // Compilation error!
-	fmt.Println("  (Structs with slices/maps cannot be compared with ==)")
+	// fmt.Fprintln(w, ph1 == ph2) // Compilation error!
+	fmt.Fprintln(w, "  (Structs with slices/maps cannot be compared with ==)")
+	fmt.Fprintln(w, "  (See STRUCT DEEP EQUALITY for the alternative)")
+}
+
+// equalPeople reports whether two people have the same name and the same
+// hobbies in the same order. Like reflect.DeepEqual, it treats a nil
+// Hobbies slice as different from an empty one.
+func equalPeople(a, b PersonWithHobbies) bool {
+	return reflect.DeepEqual(a, b)
+}
+
+// StructDeepEqual demonstrates comparing uncomparable structs
+func StructDeepEqual(w io.Writer) {
+	heading(w, "STRUCT DEEP EQUALITY (reflect.DeepEqual)")
+
+	a := PersonWithHobbies{Name: "Alice", Hobbies: []string{"reading", "chess"}}
+	b := PersonWithHobbies{Name: "Alice", Hobbies: []string{"reading", "chess"}}
+	c := PersonWithHobbies{Name: "Alice", Hobbies: []string{"chess", "reading"}}
+
+	// DeepEqual walks into slices and maps, comparing element by element
+	fmt.Fprintf(w, "a vs b (same hobbies):            %v\n", equalPeople(a, b))
+	fmt.Fprintf(w, "a vs c (same hobbies, reordered): %v (slices compare in order)\n", equalPeople(a, c))
+
+	// Subtle: a nil slice and an empty slice print the same but aren't DeepEqual
+	nilHobbies := PersonWithHobbies{Name: "Bob"}
+	emptyHobbies := PersonWithHobbies{Name: "Bob", Hobbies: []string{}}
+	fmt.Fprintf(w, "\nnil vs empty Hobbies: %v vs %v\n", nilHobbies, emptyHobbies)
+	fmt.Fprintf(w, "equalPeople: %v  <- nil != []string{} for DeepEqual\n", equalPeople(nilHobbies, emptyHobbies))
+	fmt.Fprintln(w, "  Fixes: normalize with a constructor, or compare with slices.Equal,")
+	fmt.Fprintln(w, "  which treats nil and empty as equal (both have length 0)")
 }
 
 // StructEmbedding demonstrates struct composition
-func StructEmbedding() {
-	fmt.Println("\n=== STRUCT EMBEDDING (Composition) ===")
+func StructEmbedding(w io.Writer) {
+	heading(w, "STRUCT EMBEDDING (Composition)")
 
 	// Create employee with embedded Person
 	emp := Employee{
@@ -142,49 +273,144 @@ func StructEmbedding() {
 		Department: "Engineering",
 	}
 
-	fmt.Printf("Employee: %+v\n", emp)
+	fmt.Fprintf(w, "Employee: %+v\n", plainEmp(emp))
+	fmt.Fprintf(w, "  (%%v would use the promoted Person.String(): %v - see STRUCT STRINGER)\n", emp)
 
 	// Access embedded fields directly (promoted fields)
-	fmt.Printf("Name (promoted): %s\n", emp.Name)
-	fmt.Printf("Age (promoted): %d\n", emp.Age)
-	fmt.Printf("EmployeeID: %d\n", emp.EmployeeID)
+	fmt.Fprintf(w, "Name (promoted): %s\n", emp.Name)
+	fmt.Fprintf(w, "Age (promoted): %d\n", emp.Age)
+	fmt.Fprintf(w, "EmployeeID: %d\n", emp.EmployeeID)
 
 	// Can still access via embedded field name
-	fmt.Printf("Name (via Person): %s\n", emp.Person.Name)
+	fmt.Fprintf(w, "Name (via Person): %s\n", emp.Person.Name)
 
 	// Embedded fields can be used for "inheritance-like" behavior
-	printPerson(emp.Person) // Can pass embedded struct to functions
+	printPerson(w, emp.Person) // Can pass embedded struct to functions
+}
+
+func printPerson(w io.Writer, p Person) {
+	fmt.Fprintf(w, "  Person: %s, %d years old\n", p.Name, p.Age)
+}
+
+// Describe is shadowed by Employee.Describe for Employee values
+func (p Person) Describe() string {
+	return fmt.Sprintf("%s, %d, lives in %s", p.Name, p.Age, p.City)
+}
+
+// Introduce calls Describe on its Person receiver. Called as
+// emp.Introduce(), the receiver is emp.Person, so it still gets
+// Person.Describe: Go has no virtual methods.
+func (p Person) Introduce() string {
+	return "Hi, I'm " + p.Describe()
+}
+
+// Describe has the same name as the embedded Person.Describe, so it
+// shadows it: emp.Describe() calls this one
+func (e Employee) Describe() string {
+	return fmt.Sprintf("%s, #%d in %s", e.Name, e.EmployeeID, e.Department)
 }
 
-func printPerson(p Person) {
-	fmt.Printf("  Person: %s, %d years old\n", p.Name, p.Age)
+// describer is satisfied by both Person and Employee
+type describer interface {
+	Describe() string
+}
+
+// StructMethodShadowing demonstrates an outer method hiding an embedded one
+func StructMethodShadowing(w io.Writer) {
+	heading(w, "METHOD SHADOWING WITH EMBEDDING")
+
+	emp := Employee{
+		Person:     Person{Name: "Alice", Age: 30, City: "NYC"},
+		EmployeeID: 12345,
+		Department: "Engineering",
+	}
+
+	// The shallower method wins, just like fields
+	fmt.Fprintf(w, "emp.Describe():        %s\n", emp.Describe())
+	fmt.Fprintf(w, "emp.Person.Describe(): %s\n", emp.Person.Describe())
+
+	// Introduce is promoted from Person and runs with emp.Person as its
+	// receiver. Person knows nothing about Employee, so its call to
+	// Describe is Person.Describe, not the "override"
+	fmt.Fprintf(w, "emp.Introduce():       %s\n", emp.Introduce())
+	fmt.Fprintln(w, "  (no virtual dispatch: a promoted method never calls back into the outer type)")
+
+	// Dynamic dispatch only happens through interfaces, and it's decided
+	// by the concrete type stored in the interface value
+	for _, d := range []describer{emp.Person, emp} {
+		fmt.Fprintf(w, "describer(%T).Describe(): %s\n", d, d.Describe())
+	}
 }
 
 // StructMethods demonstrates methods on structs
-func StructMethods() {
-	fmt.Println("\n=== STRUCT METHODS ===")
+func StructMethods(w io.Writer) {
+	heading(w, "STRUCT METHODS")
 
 	p := Point{X: 3, Y: 4}
-	fmt.Printf("Point: %+v\n", p)
+	fmt.Fprintf(w, "Point: %+v\n", p)
 
 	// Call value receiver method
 	dist := p.Distance()
-	fmt.Printf("Distance from origin: %.2f\n", dist)
+	fmt.Fprintf(w, "Distance from origin: %.2f\n", dist)
 
 	// Call pointer receiver method
 	p.Scale(2)
-	fmt.Printf("After scaling by 2: %+v\n", p)
+	fmt.Fprintf(w, "After scaling by 2: %+v\n", p)
 
 	// Go automatically takes address for pointer receiver methods
 	p2 := Point{X: 1, Y: 1}
 	p2.Scale(3) // Automatically converted to (&p2).Scale(3)
-	fmt.Printf("After scaling: %+v\n", p2)
+	fmt.Fprintf(w, "After scaling: %+v\n", p2)
+
+	// Methods on a struct built from other structs
+	r := Rectangle{TopLeft: Point{X: 0, Y: 5}, BottomRight: Point{X: 4, Y: 0}}
+	fmt.Fprintf(w, "\nRectangle: %+v\n", r)
+	fmt.Fprintf(w, "Area: %d, Perimeter: %d\n", r.Area(), r.Perimeter())
+	fmt.Fprintf(w, "Contains (2, 3)? %v, Contains (5, 1)? %v\n",
+		r.Contains(Point{X: 2, Y: 3}), r.Contains(Point{X: 5, Y: 1}))
+
+	// Corners given in the "wrong" order still work (absolute differences)
+	swapped := Rectangle{TopLeft: r.BottomRight, BottomRight: r.TopLeft}
+	fmt.Fprintf(w, "Swapped corners: Area: %d, Perimeter: %d, Contains (2, 3)? %v\n",
+		swapped.Area(), swapped.Perimeter(), swapped.Contains(Point{X: 2, Y: 3}))
+}
+
+// StructMethodValues demonstrates method values and method expressions
+func StructMethodValues(w io.Writer) {
+	heading(w, "METHOD VALUES AND METHOD EXPRESSIONS")
+
+	p := Point{X: 3, Y: 4}
+	fmt.Fprintf(w, "Direct call:       p.Distance() = %.0f\n", p.Distance())
+
+	// Method value: receiver bound now, so f takes no arguments.
+	// A value receiver is COPIED at binding time.
+	f := p.Distance
+	fmt.Fprintf(w, "Method value:      f := p.Distance; f() = %.0f  (type %T)\n", f(), f)
+
+	// Method expression: no receiver yet - it becomes the first argument
+	g := Point.Distance
+	fmt.Fprintf(w, "Method expression: g := Point.Distance; g(p) = %.0f  (type %T)\n", g(p), g)
+
+	// Pointer-receiver methods belong to *Point, so the expression
+	// names the pointer type and takes a *Point
+	scale := (*Point).Scale
+	scale(&p, 2)
+	fmt.Fprintf(w, "(*Point).Scale(&p, 2) → %+v  (type %T)\n", p, scale)
+
+	// The difference shows when p changes after binding
+	p.X, p.Y = 1, 1
+	fmt.Fprintf(w, "\nAfter p = {1 1}: f() = %.2f (bound copy), g(p) = %.2f (current p)\n", f(), g(p))
+
+	// A method value with a pointer receiver binds &p, so it sees changes
+	grow := p.Scale // same as (&p).Scale
+	grow(10)
+	fmt.Fprintf(w, "grow := p.Scale; grow(10) → p = %+v\n", p)
 }
 
 // Distance calculates distance from origin (value receiver)
 // Value receivers work with copies - don't modify original
 func (p Point) Distance() float64 {
-	return float64(p.X*p.X + p.Y*p.Y) // Simplified, not actual distance
+	return math.Hypot(float64(p.X), float64(p.Y))
 }
 
 // Scale scales the point (pointer receiver)
@@ -199,18 +425,43 @@ func (p Point) String() string {
 	return fmt.Sprintf("Point(%d, %d)", p.X, p.Y)
 }
 
-// StructTags demonstrates struct tags (used by JSON, XML, etc.)
-func StructTags() {
-	fmt.Println("\n=== STRUCT TAGS ===")
-
-	// Struct with tags (commonly used with encoding packages)
-	type User struct {
-		ID        int    `json:"id"`
-		Name      string `json:"name"`
-		Email     string `json:"email,omitempty"` // Omit if empty
-		Password  string `json:"-"`               // Never serialize
-		CreatedAt string `json:"created_at"`
+// Width returns the horizontal size, whichever corner is leftmost
+func (r Rectangle) Width() int {
+	return abs(r.BottomRight.X - r.TopLeft.X)
+}
+
+// Height returns the vertical size, whichever corner is on top
+func (r Rectangle) Height() int {
+	return abs(r.BottomRight.Y - r.TopLeft.Y)
+}
+
+// Area returns width * height
+func (r Rectangle) Area() int {
+	return r.Width() * r.Height()
+}
+
+// Perimeter returns the length of the rectangle's border
+func (r Rectangle) Perimeter() int {
+	return 2 * (r.Width() + r.Height())
+}
+
+// Contains reports whether p lies inside or on the border of r
+func (r Rectangle) Contains(p Point) bool {
+	minX, maxX := min(r.TopLeft.X, r.BottomRight.X), max(r.TopLeft.X, r.BottomRight.X)
+	minY, maxY := min(r.TopLeft.Y, r.BottomRight.Y), max(r.TopLeft.Y, r.BottomRight.Y)
+	return p.X >= minX && p.X <= maxX && p.Y >= minY && p.Y <= maxY
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
 	}
+	return n
+}
+
+// StructTags demonstrates struct tags (used by JSON, XML, etc.)
+func StructTags(w io.Writer) {
+	heading(w, "STRUCT TAGS")
 
 	user := User{
 		ID:       1,
@@ -219,24 +470,56 @@ func StructTags() {
 		Password: "secret123",
 	}
 
-	fmt.Printf("User struct: %+v\n", user)
-	fmt.Println("  (Tags are metadata for packages like encoding/json)")
-	fmt.Println("  - `json:\"id\"` maps field to JSON key")
-	fmt.Println("  - `json:\"-\"` excludes field from JSON")
-	fmt.Println("  - `json:\"email,omitempty\"` omits if zero value")
+	fmt.Fprintf(w, "User struct: %+v\n", user)
+	fmt.Fprintln(w, "  (Tags are metadata for packages like encoding/json)")
+	fmt.Fprintln(w, "  - `json:\"id\"` maps field to JSON key")
+	fmt.Fprintln(w, "  - `json:\"-\"` excludes field from JSON")
+	fmt.Fprintln(w, "  - `json:\"email,omitempty\"` omits if zero value")
+}
+
+// StructTagsJSON shows the User tags in action with encoding/json
+func StructTagsJSON(w io.Writer) {
+	heading(w, "STRUCT TAGS WITH encoding/json")
+
+	user := User{
+		ID:        1,
+		Name:      "Alice",
+		Email:     "", // omitempty: dropped from the output
+		Password:  "secret123",
+		CreatedAt: "2024-01-15",
+	}
+
+	// Marshal: struct → JSON (only exported fields, keys from tags)
+	data, err := json.Marshal(user)
+	if err != nil {
+		fmt.Fprintf(w, "Marshal error: %v\n", err)
+		return
+	}
+	fmt.Fprintf(w, "Marshal:   %s\n", data)
+	fmt.Fprintln(w, "  (no \"email\": empty + omitempty, no \"password\": tagged \"-\")")
+
+	// Unmarshal: JSON → struct (keys matched via tags, case-insensitively)
+	input := `{"id": 2, "name": "Bob", "email": "bob@example.com", "password": "ignored", "created_at": "2024-02-01"}`
+	var decoded User
+	if err := json.Unmarshal([]byte(input), &decoded); err != nil {
+		fmt.Fprintf(w, "Unmarshal error: %v\n", err)
+		return
+	}
+	fmt.Fprintf(w, "Unmarshal: %+v\n", decoded)
+	fmt.Fprintln(w, "  (Password stays empty: `json:\"-\"` also blocks decoding)")
 }
 
 // StructPatternConstructor demonstrates constructor pattern
-func StructPatternConstructor() {
-	fmt.Println("\n=== PATTERN: CONSTRUCTOR FUNCTIONS ===")
+func StructPatternConstructor(w io.Writer) {
+	heading(w, "PATTERN: CONSTRUCTOR FUNCTIONS")
 
 	// Constructor function (idiomatic Go pattern)
 	p1 := NewPerson("Alice", 30)
-	fmt.Printf("Created with constructor: %+v\n", *p1)
+	fmt.Fprintf(w, "Created with constructor: %+v\n", plainPerson(*p1))
 
 	// Validation in constructor
 	p2 := NewPersonValidated("", -5)
-	fmt.Printf("Invalid person: %+v\n", p2)
+	fmt.Fprintf(w, "Invalid person: %+v\n", p2)
 }
 
 // NewPerson is a constructor function (returns pointer)
@@ -257,56 +540,14 @@ func NewPersonValidated(name string, age int) *Person {
 }
 
 // StructPatternBuilder demonstrates builder pattern
-func StructPatternBuilder() {
-	fmt.Println("\n=== PATTERN: BUILDER (Functional Options) ===")
-
-	// Builder pattern for complex structs
-	type Config struct {
-		Host    string
-		Port    int
-		Timeout int
-		Debug   bool
-	}
-
-	// Option function type
-	type ConfigOption func(*Config)
-
-	// Option functions
-	WithHost := func(host string) ConfigOption {
-		return func(c *Config) {
-			c.Host = host
-		}
-	}
-
-	WithPort := func(port int) ConfigOption {
-		return func(c *Config) {
-			c.Port = port
-		}
-	}
-
-	WithDebug := func(debug bool) ConfigOption {
-		return func(c *Config) {
-			c.Debug = debug
-		}
-	}
-
-	// Constructor with options
-	NewConfig := func(opts ...ConfigOption) *Config {
-		// Default values
-		config := &Config{
-			Host:    "localhost",
-			Port:    8080,
-			Timeout: 30,
-			Debug:   false,
-		}
+func StructPatternBuilder(w io.Writer) {
+	heading(w, "PATTERN: BUILDER (Functional Options)")
 
-		// Apply options
-		for _, opt := range opts {
-			opt(config)
-		}
+	// Config, ConfigOption, the With* options and NewConfig live in
+	// config.go so other code (and tests) can reuse them
 
-		return config
-	}
+	// Defaults only
+	fmt.Fprintf(w, "Defaults: %+v\n", *NewConfig())
 
 	// Create with custom options
 	cfg := NewConfig(
@@ -314,13 +555,33 @@ func StructPatternBuilder() {
 		WithPort(9090),
 		WithDebug(true),
 	)
+	fmt.Fprintf(w, "Custom:   %+v\n", *cfg)
 
-	fmt.Printf("Config: %+v\n", *cfg)
+	// Options apply in order, so the last one wins
+	cfg = NewConfig(WithTimeout(5*time.Second), WithTimeout(time.Minute))
+	fmt.Fprintf(w, "WithTimeout(5s), WithTimeout(1m) → Timeout: %v\n", cfg.Timeout)
+
+	// The pattern isn't tied to Config: Build works for any struct.
+	// ConfigOption values are func(*Config) underneath, so they fit too.
+	built := Build(Config{Host: "localhost", Port: 80}, WithPort(8443), WithDebug(true))
+	fmt.Fprintf(w, "\nBuild(Config{...}, WithPort(8443), WithDebug(true)): %+v\n", built)
+
+	withCity := func(city string) func(*Person) {
+		return func(p *Person) { p.City = city }
+	}
+	person := Build(Person{Name: "Dana", Age: 40}, withCity("Berlin"))
+	fmt.Fprintf(w, "Build(Person{...}, withCity(\"Berlin\")): %+v\n", plainPerson(person))
+}
+
+// Square returns n*n. It's the function under test in the table-driven
+// example below; square_test.go runs the same table as real subtests.
+func Square(n int) int {
+	return n * n
 }
 
 // StructPatternAnonymous demonstrates anonymous structs
-func StructPatternAnonymous() {
-	fmt.Println("\n=== PATTERN: ANONYMOUS STRUCTS ===")
+func StructPatternAnonymous(w io.Writer) {
+	heading(w, "PATTERN: ANONYMOUS STRUCTS")
 
 	// Anonymous struct (no type definition needed)
 	point := struct {
@@ -331,7 +592,7 @@ func StructPatternAnonymous() {
 		Y: 20,
 	}
 
-	fmt.Printf("Anonymous struct: %+v\n", point)
+	fmt.Fprintf(w, "Anonymous struct: %+v\n", point)
 
 	// Useful for one-off data structures
 	config := struct {
@@ -339,7 +600,7 @@ func StructPatternAnonymous() {
 		Count   int
 	}{true, 42}
 
-	fmt.Printf("Config: %+v\n", config)
+	fmt.Fprintf(w, "Config: %+v\n", config)
 
 	// Common in table-driven tests
 	tests := []struct {
@@ -352,66 +613,91 @@ func StructPatternAnonymous() {
 		{"negative", -3, 9},
 	}
 
-	fmt.Println("\nTable-driven test structure:")
+	fmt.Fprintln(w, "\nTable-driven test structure:")
 	for _, tt := range tests {
-		result := tt.input * tt.input
-		fmt.Printf("  %s: %d^2 = %d (expected %d) ✓\n",
-			tt.name, tt.input, result, tt.expected)
+		result := Square(tt.input)
+		mark := okMark(w)
+		if result != tt.expected {
+			mark = failMark(w)
+		}
+		fmt.Fprintf(w, "  %s: %d^2 = %d (expected %d) %s\n",
+			tt.name, tt.input, result, tt.expected, mark)
 	}
+	fmt.Fprintln(w, "  (square_test.go runs this table for real: go test -run TestSquare -v ./datastructures)")
 }
 
 // StructGotchas demonstrates common pitfalls
-func StructGotchas() {
-	fmt.Println("\n=== COMMON GOTCHAS ===")
+func StructGotchas(w io.Writer) {
+	heading(w, "COMMON GOTCHAS")
 
 	// Gotcha 1: Structs are value types
-	fmt.Println("\nGotcha 1: Structs are copied by value")
+	fmt.Fprintln(w, "\nGotcha 1: Structs are copied by value")
 	p1 := Person{Name: "Alice", Age: 30}
 	p2 := p1
 	p2.Age = 31
-	fmt.Printf("Original: %+v\n", p1)
-	fmt.Printf("Copy: %+v (independent)\n", p2)
+	fmt.Fprintf(w, "Original: %+v\n", plainPerson(p1))
+	fmt.Fprintf(w, "Copy: %+v (independent)\n", plainPerson(p2))
 
 	// Use pointers to share
 	p3 := &p1
 	p3.Age = 32
-	fmt.Printf("After pointer modification: %+v\n", p1)
+	fmt.Fprintf(w, "After pointer modification: %+v\n", plainPerson(p1))
 
 	// Gotcha 2: Comparing structs with slices
-	fmt.Println("\nGotcha 2: Structs with uncomparable fields")
-	fmt.Println("  Cannot use == on structs containing slices/maps/functions")
+	fmt.Fprintln(w, "\nGotcha 2: Structs with uncomparable fields")
+	fmt.Fprintln(w, "  Cannot use == on structs containing slices/maps/functions")
 
 	// Gotcha 3: Method receivers
-	fmt.Println("\nGotcha 3: Value vs Pointer receivers")
-	fmt.Println("  Value receivers: Work on copies, can't modify original")
-	fmt.Println("  Pointer receivers: Can modify original, more efficient for large structs")
+	fmt.Fprintln(w, "\nGotcha 3: Value vs Pointer receivers")
+	fmt.Fprintln(w, "  Value receivers: Work on copies, can't modify original")
+	fmt.Fprintln(w, "  Pointer receivers: Can modify original, more efficient for large structs")
+	fmt.Fprintln(w, "    (measure the copy cost of an 8 KiB struct yourself:")
+	fmt.Fprintln(w, "     go test -bench=LargeStruct -benchmem ./datastructures)")
 
 	point1 := Point{X: 1, Y: 1}
 	point1.Distance() // Value receiver - works on copy
 	point1.Scale(2)   // Pointer receiver - modifies original
 
 	// Gotcha 4: Zero values
-	fmt.Println("\nGotcha 4: Zero values can be problematic")
+	fmt.Fprintln(w, "\nGotcha 4: Zero values can be problematic")
 	var p4 Person // All fields are zero values
-	fmt.Printf("Zero Person: %+v\n", p4)
-	fmt.Println("  Empty strings and 0 might not be valid business values")
-	fmt.Println("  Use constructor functions for validation and defaults")
+	fmt.Fprintf(w, "Zero Person: %+v\n", plainPerson(p4))
+	fmt.Fprintln(w, "  Empty strings and 0 might not be valid business values")
+	fmt.Fprintln(w, "  Use constructor functions for validation and defaults")
 }
 
 // RunStructs runs all struct examples
-func RunStructs() {
-	fmt.Println("\n" + strings.Repeat("=", 60))
-	fmt.Println("STRUCTS IN GO")
-	fmt.Println(strings.Repeat("=", 60))
-
-	StructBasics()
-	StructPointers()
-	StructComparison()
-	StructEmbedding()
-	StructMethods()
-	StructTags()
-	StructPatternConstructor()
-	StructPatternBuilder()
-	StructPatternAnonymous()
-	StructGotchas()
+func RunStructs(w io.Writer) {
+	topicBanner("STRUCTS IN GO", w)
+
+	StructBasics(w)
+	StructLiteralPitfall(w)
+	StructStringer(w)
+	StructPointers(w)
+	StructComparison(w)
+	StructDeepEqual(w)
+	StructEmbedding(w)
+	StructMethodShadowing(w)
+	StructInterfaceEmbedding(w)
+	StructPromotedInterface(w)
+	StructMethods(w)
+	StructMethodValues(w)
+	StructTags(w)
+	StructTagsJSON(w)
+	demoAdvancedTags(w)
+	StructTagsFlags(w)
+	StructTagsEnv(w)
+	StructReflection(w)
+	TypeAssertionHelpers(w)
+	StructFlatten(w)
+	StructTreeOutline(w)
+	StructPatternHierarchy(w)
+	StructPatternColumnar(w)
+	StructPatternConstructor(w)
+	StructPatternValidation(w)
+	StructPatternBuilder(w)
+	StructPatternAnonymous(w)
+	StructGotchas(w)
+	StructCopySemantics(w)
+	StructDeepCopy(w)
 }
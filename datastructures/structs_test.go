@@ -0,0 +1,215 @@
+package datastructures
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestMethodShadowing(t *testing.T) {
+	emp := Employee{
+		Person:     Person{Name: "Alice", Age: 30, City: "NYC"},
+		EmployeeID: 7,
+		Department: "Research",
+	}
+	const (
+		empDesc    = "Alice, #7 in Research"
+		personDesc = "Alice, 30, lives in NYC"
+	)
+
+	if got := emp.Describe(); got != empDesc {
+		t.Errorf("emp.Describe() = %q, want Employee's %q", got, empDesc)
+	}
+	if got := emp.Person.Describe(); got != personDesc {
+		t.Errorf("emp.Person.Describe() = %q, want Person's %q", got, personDesc)
+	}
+	// The promoted Introduce only sees the embedded Person
+	if got, want := emp.Introduce(), "Hi, I'm "+personDesc; got != want {
+		t.Errorf("emp.Introduce() = %q, want %q", got, want)
+	}
+	// Through an interface, the dynamic type decides
+	var d describer = emp
+	if got := d.Describe(); got != empDesc {
+		t.Errorf("describer(emp).Describe() = %q, want %q", got, empDesc)
+	}
+}
+
+// Copy-cost benchmarks for the StructGotchas claim that pointer receivers
+// are "more efficient for large structs".
+//
+//	go test -bench=LargeStruct ./datastructures
+//
+// largeStruct is 8 KiB (1024 ints). Every value-receiver call and every
+// by-value argument copies all of it; the pointer versions copy 8 bytes.
+// The value variants should come out clearly slower - by how much
+// depends on the machine - while for a small struct like Point the
+// difference disappears into the noise.
+
+type largeStruct struct {
+	data [1024]int
+}
+
+// The methods and functions are noinline so the compiler can't optimize
+// the copy away; that keeps the benchmark about copying, not inlining
+
+//go:noinline
+func (s largeStruct) sumValue() int { return s.data[0] + s.data[1023] }
+
+//go:noinline
+func (s *largeStruct) sumPointer() int { return s.data[0] + s.data[1023] }
+
+//go:noinline
+func passByValue(s largeStruct) int { return s.data[0] + s.data[1023] }
+
+//go:noinline
+func passByPointer(s *largeStruct) int { return s.data[0] + s.data[1023] }
+
+var largeSink int
+
+func BenchmarkLargeStructValueReceiver(b *testing.B) {
+	var s largeStruct
+	for i := 0; i < b.N; i++ {
+		largeSink = s.sumValue()
+	}
+}
+
+func BenchmarkLargeStructPointerReceiver(b *testing.B) {
+	var s largeStruct
+	for i := 0; i < b.N; i++ {
+		largeSink = s.sumPointer()
+	}
+}
+
+func BenchmarkLargeStructPassByValue(b *testing.B) {
+	var s largeStruct
+	for i := 0; i < b.N; i++ {
+		largeSink = passByValue(s)
+	}
+}
+
+func BenchmarkLargeStructPassByPointer(b *testing.B) {
+	var s largeStruct
+	for i := 0; i < b.N; i++ {
+		largeSink = passByPointer(&s)
+	}
+}
+
+func TestRectangleSwappedCorners(t *testing.T) {
+	// The same 4x5 rectangle, with its corners given in every order
+	rects := []Rectangle{
+		{TopLeft: Point{X: 0, Y: 5}, BottomRight: Point{X: 4, Y: 0}},
+		{TopLeft: Point{X: 4, Y: 0}, BottomRight: Point{X: 0, Y: 5}}, // fully swapped
+		{TopLeft: Point{X: 4, Y: 5}, BottomRight: Point{X: 0, Y: 0}}, // X reversed
+		{TopLeft: Point{X: 0, Y: 0}, BottomRight: Point{X: 4, Y: 5}}, // Y reversed
+	}
+	for _, r := range rects {
+		if got := r.Area(); got != 20 {
+			t.Errorf("%+v: Area() = %d, want 20", r, got)
+		}
+		if got := r.Perimeter(); got != 18 {
+			t.Errorf("%+v: Perimeter() = %d, want 18", r, got)
+		}
+		for _, tt := range []struct {
+			p    Point
+			want bool
+		}{
+			{Point{X: 2, Y: 3}, true},
+			{Point{X: 0, Y: 0}, true}, // corners and edges count
+			{Point{X: 4, Y: 5}, true},
+			{Point{X: 5, Y: 1}, false},
+			{Point{X: 2, Y: -1}, false},
+		} {
+			if got := r.Contains(tt.p); got != tt.want {
+				t.Errorf("%+v: Contains(%v) = %v, want %v", r, tt.p, got, tt.want)
+			}
+		}
+	}
+
+	flat := Rectangle{TopLeft: Point{X: 3, Y: 2}, BottomRight: Point{X: 3, Y: 7}}
+	if flat.Area() != 0 || flat.Perimeter() != 10 {
+		t.Errorf("zero-width rectangle: Area=%d Perimeter=%d, want 0 and 10", flat.Area(), flat.Perimeter())
+	}
+}
+
+func TestPersonString(t *testing.T) {
+	p := Person{Name: "Alice", Age: 30, City: "NYC"}
+	tests := []struct {
+		format string
+		arg    any
+		want   string
+	}{
+		{"%v", p, "Alice (30, NYC)"},
+		{"%s", p, "Alice (30, NYC)"},
+		{"%+v", p, "Alice (30, NYC)"}, // %+v calls String too
+		{"%v", Person{}, " (0, )"},
+		{"%v", Employee{Person: p, EmployeeID: 7}, "Alice (30, NYC)"}, // promoted
+		{"%+v", plainPerson(p), "{Name:Alice Age:30 City:NYC}"},
+		{"%+v", plainEmp(Employee{Person: p, EmployeeID: 7, Department: "Sales"}),
+			"{Person:{Name:Alice Age:30 City:NYC} EmployeeID:7 Department:Sales}"},
+	}
+	for _, tt := range tests {
+		if got := fmt.Sprintf(tt.format, tt.arg); got != tt.want {
+			t.Errorf("Sprintf(%q, %#v) = %q, want %q", tt.format, tt.arg, got, tt.want)
+		}
+	}
+}
+
+func TestMethodValueAndExpression(t *testing.T) {
+	for _, p := range []Point{{X: 3, Y: 4}, {X: 0, Y: 0}, {X: -5, Y: 12}} {
+		direct := p.Distance()
+		f := p.Distance        // method value
+		g := Point.Distance    // method expression
+		h := (*Point).Distance // value methods are in *Point's method set too
+		if f() != direct || g(p) != direct || h(&p) != direct {
+			t.Errorf("%v: f() = %v, g(p) = %v, h(&p) = %v, want all %v", p, f(), g(p), h(&p), direct)
+		}
+	}
+
+	// Pointer-receiver method: every form mutates the same way
+	want := Point{X: 3, Y: 4}
+	want.Scale(2)
+
+	a := Point{X: 3, Y: 4}
+	(*Point).Scale(&a, 2)
+	b := Point{X: 3, Y: 4}
+	grow := b.Scale // binds &b
+	grow(2)
+	if a != want || b != want {
+		t.Errorf("(*Point).Scale(&a, 2) = %v, b.Scale bound then called = %v, want both %v", a, b, want)
+	}
+
+	// A value-receiver method value copies the receiver when it is bound
+	p := Point{X: 3, Y: 4}
+	f := p.Distance
+	p.X, p.Y = 0, 0
+	if got := f(); got != 5 {
+		t.Errorf("bound p.Distance after changing p = %v, want 5 (the copy taken at binding)", got)
+	}
+}
+
+func TestEqualPeople(t *testing.T) {
+	alice := func(hobbies ...string) PersonWithHobbies {
+		return PersonWithHobbies{Name: "Alice", Hobbies: hobbies}
+	}
+	tests := []struct {
+		name string
+		a, b PersonWithHobbies
+		want bool
+	}{
+		{"equal", alice("reading", "chess"), alice("reading", "chess"), true},
+		{"differing hobby", alice("reading", "chess"), alice("reading", "poker"), false},
+		{"same hobbies reordered", alice("reading", "chess"), alice("chess", "reading"), false},
+		{"extra hobby", alice("reading"), alice("reading", "chess"), false},
+		{"differing name", alice("reading"), PersonWithHobbies{Name: "Bob", Hobbies: []string{"reading"}}, false},
+		{"nil vs empty", PersonWithHobbies{Name: "Bob"}, PersonWithHobbies{Name: "Bob", Hobbies: []string{}}, false},
+		{"nil vs nil", PersonWithHobbies{Name: "Bob"}, PersonWithHobbies{Name: "Bob"}, true},
+		{"empty vs empty", PersonWithHobbies{Hobbies: []string{}}, PersonWithHobbies{Hobbies: []string{}}, true},
+	}
+	for _, tt := range tests {
+		if got := equalPeople(tt.a, tt.b); got != tt.want {
+			t.Errorf("%s: equalPeople(%#v, %#v) = %v, want %v", tt.name, tt.a, tt.b, got, tt.want)
+		}
+		if got := equalPeople(tt.b, tt.a); got != tt.want {
+			t.Errorf("%s: equalPeople is not symmetric: swapped = %v", tt.name, got)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package datastructures
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+)
+
+// sync.Pool: REUSING ALLOCATIONS
+// ==============================
+// new() and make() decide HOW memory is allocated; a pool decides how
+// OFTEN. sync.Pool keeps released objects around for the next Get, so a
+// hot path can stop allocating (and stop feeding the garbage collector).
+// - Get returns a pooled object, or calls New when the pool is empty
+// - Put hands an object back; the GC may still drop pooled objects
+// - Pooled objects keep their old contents: ALWAYS reset before reuse
+
+// bufPool holds *[]byte rather than []byte: putting a slice header into
+// an interface would itself allocate, defeating the point of the pool
+var bufPool = sync.Pool{
+	New: func() any {
+		b := make([]byte, 0, 256)
+		return &b
+	},
+}
+
+// writeRecordAlloc writes a record using a fresh buffer on every call.
+// Passing buf to an io.Writer makes it escape, so it lands on the heap.
+func writeRecordAlloc(w io.Writer, id int, name string) {
+	buf := make([]byte, 0, 256)
+	buf = appendRecord(buf, id, name)
+	w.Write(buf)
+}
+
+// writeRecordPooled writes the same record using a buffer from bufPool
+func writeRecordPooled(w io.Writer, id int, name string) {
+	bp := bufPool.Get().(*[]byte)
+	buf := appendRecord((*bp)[:0], id, name) // [:0] is the reset
+	w.Write(buf)
+	*bp = buf // keep any growth for next time
+	bufPool.Put(bp)
+}
+
+func appendRecord(buf []byte, id int, name string) []byte {
+	buf = append(buf, "id="...)
+	buf = strconv.AppendInt(buf, int64(id), 10)
+	buf = append(buf, " name="...)
+	return append(buf, name...)
+}
+
+// SyncPoolExample demonstrates reusing buffers with sync.Pool
+func SyncPoolExample(w io.Writer) {
+	heading(w, "REUSING MEMORY WITH sync.Pool")
+
+	fmt.Fprint(w, "Allocating: ")
+	writeRecordAlloc(w, 1, "alice")
+	fmt.Fprint(w, "\nPooled:     ")
+	writeRecordPooled(w, 2, "bob")
+	fmt.Fprintln(w)
+
+	// Why reset matters: a pooled buffer still holds its last contents
+	var pool sync.Pool
+	stale := []byte("secret-token")
+	pool.Put(&stale)
+	if bp, ok := pool.Get().(*[]byte); ok {
+		fmt.Fprintf(w, "Got back without reset: %q\n", *bp)
+		*bp = (*bp)[:0]
+		fmt.Fprintf(w, "After (*bp)[:0]:        %q (capacity %d kept)\n", *bp, cap(*bp))
+	} else {
+		// Pools may drop objects at any GC, so Get can come back empty
+		fmt.Fprintln(w, "Pool was emptied by the GC - Get returned nil")
+	}
+
+	fmt.Fprintln(w, "\nRules of thumb:")
+	fmt.Fprintln(w, "  - Reset on Get (or before Put): old data can leak into new work")
+	fmt.Fprintln(w, "  - Never keep using an object after Put - someone else may own it")
+	fmt.Fprintln(w, "  - Copy out anything you keep (string(buf)) before Put")
+	fmt.Fprintln(w, "  - Measure first: go test -bench=Record -benchmem ./datastructures")
+}
@@ -0,0 +1,28 @@
+package datastructures
+
+import (
+	"io"
+	"testing"
+)
+
+// Allocate-per-call vs pool-backed buffers, building the same record.
+//
+//	go test -bench=Record -benchmem ./datastructures
+//
+// The allocating version reports 1 allocs/op (256 B/op) because the
+// buffer escapes to the heap; the pooled version reports 0 allocs/op once
+// the pool is warm. The time difference is mostly GC work saved.
+
+func BenchmarkRecordAlloc(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		writeRecordAlloc(io.Discard, i, "alice")
+	}
+}
+
+func BenchmarkRecordPooled(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		writeRecordPooled(io.Discard, i, "alice")
+	}
+}
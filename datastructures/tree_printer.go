@@ -0,0 +1,62 @@
+package datastructures
+
+import (
+	"fmt"
+	"io"
+)
+
+// TREE OUTLINE PRINTER
+// ====================
+// Renders any tree-shaped data like the Unix `tree` command.
+// - Works with anything implementing the small TreeNode interface
+// - "├── " marks a child with siblings below it, "└── " the last child
+// - "│   " continues a parent's branch past nested children
+
+// TreeNode is the minimal interface PrintTree needs
+type TreeNode interface {
+	Label() string
+	Children() []TreeNode
+}
+
+// PrintTree writes an indented outline of root and its descendants to w
+func PrintTree(root TreeNode, w io.Writer) {
+	fmt.Fprintln(w, root.Label())
+	printChildren(root, "", w)
+}
+
+func printChildren(node TreeNode, prefix string, w io.Writer) {
+	children := node.Children()
+	for i, child := range children {
+		connector, extension := "├── ", "│   "
+		if i == len(children)-1 {
+			connector, extension = "└── ", "    "
+		}
+		fmt.Fprintf(w, "%s%s%s\n", prefix, connector, child.Label())
+		printChildren(child, prefix+extension, w)
+	}
+}
+
+// outlineNode is a simple TreeNode built from literals
+type outlineNode struct {
+	label    string
+	children []TreeNode
+}
+
+func (n outlineNode) Label() string        { return n.label }
+func (n outlineNode) Children() []TreeNode { return n.children }
+
+// StructTreeOutline demonstrates printing a tree through an interface
+func StructTreeOutline(w io.Writer) {
+	heading(w, "TREE OUTLINE (Interface-driven printing)")
+
+	project := outlineNode{label: "datastructures/", children: []TreeNode{
+		outlineNode{label: "main.go"},
+		outlineNode{label: "docs/", children: []TreeNode{
+			outlineNode{label: "README.md"},
+			outlineNode{label: "QUICK_REFERENCE.md"},
+		}},
+		outlineNode{label: "structs.go"},
+	}}
+
+	PrintTree(project, w)
+}
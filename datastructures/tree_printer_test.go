@@ -0,0 +1,46 @@
+package datastructures
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPrintTreeGolden(t *testing.T) {
+	root := outlineNode{label: "root", children: []TreeNode{
+		outlineNode{label: "a", children: []TreeNode{
+			outlineNode{label: "a1"},
+			outlineNode{label: "a2", children: []TreeNode{
+				outlineNode{label: "a2x"},
+			}},
+		}},
+		outlineNode{label: "b"},
+		outlineNode{label: "c", children: []TreeNode{
+			outlineNode{label: "c1"},
+		}},
+	}}
+
+	// "│   " continues a branch that has more siblings below; the last
+	// child's subtree is indented with plain spaces instead
+	const want = `root
+├── a
+│   ├── a1
+│   └── a2
+│       └── a2x
+├── b
+└── c
+    └── c1
+`
+	var sb strings.Builder
+	PrintTree(root, &sb)
+	if got := sb.String(); got != want {
+		t.Errorf("PrintTree output:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestPrintTreeLeaf(t *testing.T) {
+	var sb strings.Builder
+	PrintTree(outlineNode{label: "alone"}, &sb)
+	if got := sb.String(); got != "alone\n" {
+		t.Errorf("PrintTree(leaf) = %q, want %q", got, "alone\n")
+	}
+}
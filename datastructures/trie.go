@@ -0,0 +1,144 @@
+package datastructures
+
+import (
+	"fmt"
+	"io"
+	"sort"
+)
+
+// TRIES (Prefix Trees)
+// ====================
+// A map answers "is this exact key present?". A trie also answers
+// "which keys start with this prefix?" - autocomplete, routing tables,
+// spell checkers. Each node is one character; a word is a path from the
+// root, and end marks the nodes where a stored word stops.
+// - Insert/Contains/HasPrefix cost O(len(word)), independent of word count
+// - Children live in a map[rune]*trieNode, so any Unicode text works
+
+// trieNode is one character position in the trie
+type trieNode struct {
+	children map[rune]*trieNode
+	end      bool // a stored word ends here
+}
+
+func newTrieNode() *trieNode {
+	return &trieNode{children: make(map[rune]*trieNode)}
+}
+
+// Trie is a set of strings supporting prefix queries.
+// The zero value is not usable; create one with NewTrie.
+type Trie struct {
+	root *trieNode
+	size int
+}
+
+// NewTrie returns an empty trie
+func NewTrie() *Trie {
+	return &Trie{root: newTrieNode()}
+}
+
+// Insert adds word to the trie. Inserting "" stores the empty word.
+func (t *Trie) Insert(word string) {
+	n := t.root
+	for _, r := range word {
+		child, ok := n.children[r]
+		if !ok {
+			child = newTrieNode()
+			n.children[r] = child
+		}
+		n = child
+	}
+	if !n.end {
+		n.end = true
+		t.size++
+	}
+}
+
+// Contains reports whether word itself was inserted
+func (t *Trie) Contains(word string) bool {
+	n := t.find(word)
+	return n != nil && n.end
+}
+
+// HasPrefix reports whether any inserted word starts with prefix.
+// Every word starts with "", so HasPrefix("") is true for a non-empty trie.
+func (t *Trie) HasPrefix(prefix string) bool {
+	n := t.find(prefix)
+	return n != nil && (n.end || len(n.children) > 0)
+}
+
+// WordsWithPrefix returns every inserted word starting with prefix,
+// in sorted order
+func (t *Trie) WordsWithPrefix(prefix string) []string {
+	n := t.find(prefix)
+	if n == nil {
+		return nil
+	}
+	var words []string
+	collectWords(n, []rune(prefix), &words)
+	return words
+}
+
+// Len returns the number of distinct words stored
+func (t *Trie) Len() int {
+	return t.size
+}
+
+// find walks the path for s, returning nil if it leaves the trie
+func (t *Trie) find(s string) *trieNode {
+	n := t.root
+	for _, r := range s {
+		n = n.children[r]
+		if n == nil {
+			return nil
+		}
+	}
+	return n
+}
+
+// collectWords appends every word below n, visiting children in rune
+// order so the output is sorted (map iteration order is random)
+func collectWords(n *trieNode, path []rune, words *[]string) {
+	if n.end {
+		*words = append(*words, string(path))
+	}
+	keys := make([]rune, 0, len(n.children))
+	for r := range n.children {
+		keys = append(keys, r)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	for _, r := range keys {
+		collectWords(n.children[r], append(path, r), words)
+	}
+}
+
+// RunTrie runs the trie examples
+func RunTrie(w io.Writer) {
+	topicBanner("TRIES (PREFIX TREES) IN GO", w)
+
+	heading(w, "BUILDING A TRIE")
+	trie := NewTrie()
+	words := []string{"car", "card", "care", "careful", "cart", "cat", "dog", "do", "car"}
+	for _, word := range words {
+		trie.Insert(word)
+	}
+	fmt.Fprintf(w, "Inserted %q\n", words)
+	fmt.Fprintf(w, "Len: %d (\"car\" was inserted twice but stored once)\n", trie.Len())
+
+	heading(w, "EXACT MATCH vs PREFIX")
+	for _, q := range []string{"car", "ca", "careful", "carefully", "do", "d"} {
+		fmt.Fprintf(w, "%-12q Contains=%-5v HasPrefix=%v\n", q, trie.Contains(q), trie.HasPrefix(q))
+	}
+	fmt.Fprintln(w, "  (\"ca\" is only a prefix; \"car\" is both a word and a prefix)")
+
+	heading(w, "AUTOCOMPLETE")
+	for _, prefix := range []string{"car", "care", "d", "x"} {
+		fmt.Fprintf(w, "WordsWithPrefix(%q) = %q\n", prefix, trie.WordsWithPrefix(prefix))
+	}
+
+	heading(w, "THE EMPTY STRING")
+	fmt.Fprintf(w, "Contains(\"\") = %v (not inserted yet)\n", trie.Contains(""))
+	fmt.Fprintf(w, "HasPrefix(\"\") = %v (every word starts with \"\")\n", trie.HasPrefix(""))
+	trie.Insert("")
+	fmt.Fprintf(w, "After Insert(\"\"): Contains(\"\") = %v, Len = %d\n", trie.Contains(""), trie.Len())
+}
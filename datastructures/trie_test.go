@@ -0,0 +1,95 @@
+package datastructures
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestTrieOverlappingPrefixes(t *testing.T) {
+	tr := NewTrie()
+	for _, w := range []string{"car", "card", "care", "careful", "cat", "dog", "car"} {
+		tr.Insert(w)
+	}
+	if got := tr.Len(); got != 6 {
+		t.Errorf("Len() = %d, want 6 (duplicate \"car\" counted once)", got)
+	}
+
+	contains := []struct {
+		word string
+		want bool
+	}{
+		{"car", true},
+		{"card", true},
+		{"careful", true},
+		{"ca", false},    // only a prefix
+		{"caref", false}, // a prefix inside another word
+		{"cards", false}, // runs off the end of the trie
+		{"do", false},
+	}
+	for _, tt := range contains {
+		if got := tr.Contains(tt.word); got != tt.want {
+			t.Errorf("Contains(%q) = %v, want %v", tt.word, got, tt.want)
+		}
+	}
+
+	prefixes := []struct {
+		prefix  string
+		has     bool
+		matches []string
+	}{
+		{"ca", true, []string{"car", "card", "care", "careful", "cat"}},
+		{"car", true, []string{"car", "card", "care", "careful"}}, // the word itself is included
+		{"care", true, []string{"care", "careful"}},
+		{"careful", true, []string{"careful"}},
+		{"carefully", false, nil},
+		{"x", false, nil},
+	}
+	for _, tt := range prefixes {
+		if got := tr.HasPrefix(tt.prefix); got != tt.has {
+			t.Errorf("HasPrefix(%q) = %v, want %v", tt.prefix, got, tt.has)
+		}
+		if got := tr.WordsWithPrefix(tt.prefix); !slices.Equal(got, tt.matches) {
+			t.Errorf("WordsWithPrefix(%q) = %v, want %v", tt.prefix, got, tt.matches)
+		}
+	}
+}
+
+func TestTrieEmptyString(t *testing.T) {
+	tr := NewTrie()
+	if tr.HasPrefix("") {
+		t.Error("HasPrefix(\"\") on an empty trie = true, want false")
+	}
+	if tr.Contains("") {
+		t.Error("Contains(\"\") on an empty trie = true, want false")
+	}
+	if got := tr.WordsWithPrefix(""); len(got) != 0 {
+		t.Errorf("WordsWithPrefix(\"\") on an empty trie = %v, want none", got)
+	}
+
+	tr.Insert("go")
+	if !tr.HasPrefix("") {
+		t.Error("HasPrefix(\"\") = false, want true: every word starts with \"\"")
+	}
+	if tr.Contains("") {
+		t.Error("Contains(\"\") = true before inserting \"\"")
+	}
+
+	// The empty word can be stored like any other
+	tr.Insert("")
+	if !tr.Contains("") || tr.Len() != 2 {
+		t.Errorf("after Insert(\"\"): Contains(\"\") = %v, Len() = %d, want true, 2", tr.Contains(""), tr.Len())
+	}
+	if got, want := tr.WordsWithPrefix(""), []string{"", "go"}; !slices.Equal(got, want) {
+		t.Errorf("WordsWithPrefix(\"\") = %q, want %q", got, want)
+	}
+}
+
+func TestTrieUnicode(t *testing.T) {
+	tr := NewTrie()
+	for _, w := range []string{"café", "cafés", "cafe"} {
+		tr.Insert(w)
+	}
+	if got, want := tr.WordsWithPrefix("caf"), []string{"cafe", "café", "cafés"}; !slices.Equal(got, want) {
+		t.Errorf("WordsWithPrefix(\"caf\") = %v, want %v", got, want)
+	}
+}
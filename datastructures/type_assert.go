@@ -0,0 +1,53 @@
+package datastructures
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// SAFE TYPE ASSERTIONS
+// ====================
+// v.(T) panics if v doesn't hold a T; the comma-ok form v.(T) returns
+// ok=false instead. Generics let us wrap both forms once:
+// - As[T]:     comma-ok, returns the zero value and false on mismatch
+// - MustAs[T]: panics with a readable "expected X, got Y" message
+
+// As asserts that v holds a T
+func As[T any](v any) (T, bool) {
+	t, ok := v.(T)
+	return t, ok
+}
+
+// MustAs asserts that v holds a T, panicking with a clear message if not
+func MustAs[T any](v any) T {
+	t, ok := v.(T)
+	if !ok {
+		panic(fmt.Sprintf("MustAs: expected %v, got %T", reflect.TypeFor[T](), v))
+	}
+	return t
+}
+
+// TypeAssertionHelpers demonstrates the generic assertion helpers
+func TypeAssertionHelpers(w io.Writer) {
+	heading(w, "SAFE TYPE ASSERTIONS (Generics)")
+
+	values := []any{42, "hello", Point{X: 1, Y: 2}, nil}
+	for _, v := range values {
+		n, ok := As[int](v)
+		fmt.Fprintf(w, "As[int](%v) → %d, ok=%v\n", v, n, ok)
+	}
+
+	// Interfaces work too: any type with String() is a fmt.Stringer
+	if s, ok := As[fmt.Stringer](Point{X: 3, Y: 4}); ok {
+		fmt.Fprintf(w, "As[fmt.Stringer](Point) → %s\n", s)
+	}
+
+	fmt.Fprintf(w, "MustAs[string](\"hello\") → %s\n", MustAs[string]("hello"))
+	func() {
+		defer func() {
+			fmt.Fprintf(w, "MustAs[int](\"hello\") panicked: %v\n", recover())
+		}()
+		MustAs[int]("hello")
+	}()
+}
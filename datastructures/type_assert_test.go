@@ -0,0 +1,55 @@
+package datastructures
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestAs(t *testing.T) {
+	if n, ok := As[int](42); !ok || n != 42 {
+		t.Errorf("As[int](42) = %d, %v, want 42, true", n, ok)
+	}
+	if s, ok := As[fmt.Stringer](Point{X: 3, Y: 4}); !ok || s.String() != (Point{X: 3, Y: 4}).String() {
+		t.Errorf("As[fmt.Stringer](Point) = %v, %v, want the Point, true", s, ok)
+	}
+
+	// Failures return the zero value of T and false
+	if n, ok := As[int]("hello"); ok || n != 0 {
+		t.Errorf("As[int](\"hello\") = %d, %v, want 0, false", n, ok)
+	}
+	if n, ok := As[int](nil); ok || n != 0 {
+		t.Errorf("As[int](nil) = %d, %v, want 0, false", n, ok)
+	}
+	if p, ok := As[*Point](Point{}); ok || p != nil {
+		t.Errorf("As[*Point](Point{}) = %v, %v, want nil, false", p, ok)
+	}
+}
+
+func TestMustAs(t *testing.T) {
+	if got := MustAs[string]("hello"); got != "hello" {
+		t.Errorf("MustAs[string](\"hello\") = %q, want %q", got, "hello")
+	}
+
+	tests := []struct {
+		name string
+		call func()
+		want string
+	}{
+		{"int from string", func() { MustAs[int]("hello") }, "MustAs: expected int, got string"},
+		{"int from nil", func() { MustAs[int](nil) }, "MustAs: expected int, got <nil>"},
+		{"interface", func() { MustAs[fmt.Stringer](42) }, "MustAs: expected fmt.Stringer, got int"},
+		{"named type", func() { MustAs[Point](1.5) }, "MustAs: expected datastructures.Point, got float64"},
+	}
+	for _, tt := range tests {
+		if got := panicValue(tt.call); got != tt.want {
+			t.Errorf("%s: panic = %#v, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+// panicValue runs f and returns what it panicked with, or nil
+func panicValue(f func()) (v any) {
+	defer func() { v = recover() }()
+	f()
+	return nil
+}
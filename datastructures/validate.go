@@ -0,0 +1,130 @@
+package datastructures
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// TAG-DRIVEN VALIDATION
+// =====================
+// NewPersonValidated hard-codes its checks. Tags let one function
+// validate any struct: `validate:"required,min=0,max=150"`
+// - required: the field must not be its zero value
+// - min=N / max=N: numeric bounds (for strings and slices: length)
+// - Nested and embedded structs are validated recursively
+
+// Validate checks every `validate` tag in the struct v (or pointer to it)
+// and returns all violations joined into one error, or nil
+func Validate(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Pointer {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return fmt.Errorf("validate: expected struct, got %T", v)
+	}
+
+	var errs []error
+	validateStruct(rv, "", &errs)
+	return errors.Join(errs...) // nil when errs is empty
+}
+
+func validateStruct(rv reflect.Value, prefix string, errs *[]error) {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		value := rv.Field(i)
+
+		path := prefix + field.Name
+		if field.Anonymous {
+			path = strings.TrimSuffix(prefix, ".") // embedded fields are promoted
+		}
+
+		if tag := field.Tag.Get("validate"); tag != "" {
+			for _, rule := range strings.Split(tag, ",") {
+				if err := checkRule(path, value, rule); err != nil {
+					*errs = append(*errs, err)
+				}
+			}
+		}
+
+		if value.Kind() == reflect.Struct {
+			next := path + "."
+			if field.Anonymous {
+				next = prefix
+			}
+			validateStruct(value, next, errs)
+		}
+	}
+}
+
+func checkRule(path string, value reflect.Value, rule string) error {
+	name, arg, _ := strings.Cut(rule, "=")
+	switch name {
+	case "required":
+		if value.IsZero() {
+			return fmt.Errorf("%s is required", path)
+		}
+	case "min", "max":
+		limit, err := strconv.ParseFloat(arg, 64)
+		if err != nil {
+			return fmt.Errorf("%s: bad %s value %q", path, name, arg)
+		}
+		n, what, ok := measure(value)
+		if !ok {
+			return fmt.Errorf("%s: %s not supported for %s", path, name, value.Kind())
+		}
+		if name == "min" && n < limit {
+			return fmt.Errorf("%s %s %v is below min %v", path, what, n, limit)
+		}
+		if name == "max" && n > limit {
+			return fmt.Errorf("%s %s %v is above max %v", path, what, n, limit)
+		}
+	default:
+		return fmt.Errorf("%s: unknown rule %q", path, name)
+	}
+	return nil
+}
+
+// measure returns the number min/max compare against
+func measure(v reflect.Value) (n float64, what string, ok bool) {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), "value", true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), "value", true
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), "value", true
+	case reflect.String, reflect.Slice, reflect.Map:
+		return float64(v.Len()), "length", true
+	}
+	return 0, "", false
+}
+
+// StructPatternValidation demonstrates reusable tag-based validation
+func StructPatternValidation(w io.Writer) {
+	heading(w, "PATTERN: VALIDATION WITH STRUCT TAGS")
+
+	valid := Person{Name: "Alice", Age: 30, City: "NYC"}
+	fmt.Fprintf(w, "Validate(%+v): %v\n", plainPerson(valid), Validate(valid))
+
+	invalid := Person{Name: "", Age: 200}
+	fmt.Fprintf(w, "Validate(%+v):\n", plainPerson(invalid))
+	if err := Validate(invalid); err != nil {
+		// errors.Join puts each violation on its own line
+		for _, line := range strings.Split(err.Error(), "\n") {
+			fmt.Fprintf(w, "  %s %s\n", printerOf(w).colorize("✗", ansiRed), line)
+		}
+	}
+
+	// Embedded structs are checked too, with promoted field names
+	emp := Employee{Person: Person{Name: "Bob", Age: -1}, EmployeeID: 7}
+	fmt.Fprintf(w, "Validate(Employee with Age -1): %v\n", Validate(emp))
+}
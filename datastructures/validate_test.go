@@ -0,0 +1,59 @@
+package datastructures
+
+import (
+	"strings"
+	"testing"
+)
+
+// violations splits a Validate error into its joined lines
+func violations(err error) []string {
+	if err == nil {
+		return nil
+	}
+	return strings.Split(err.Error(), "\n")
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		v    any
+		want []string // one entry per expected violation
+	}{
+		{"valid", Person{Name: "Alice", Age: 30}, nil},
+		{"boundaries are inclusive", Person{Name: "A", Age: 150}, nil},
+		{"missing required", Person{Age: 30}, []string{"Name is required"}},
+		{"above max", Person{Name: "A", Age: 200}, []string{"Age value 200 is above max 150"}},
+		{"below min", Person{Name: "A", Age: -1}, []string{"Age value -1 is below min 0"}},
+		{"all violations reported", Person{Age: 151}, []string{"Name is required", "Age value 151 is above max 150"}},
+		{"embedded, promoted names", Employee{Person: Person{Age: -5}},
+			[]string{"Name is required", "Age value -5 is below min 0"}},
+		{"pointer", &Person{Name: "A", Age: 999}, []string{"Age value 999 is above max 150"}},
+	}
+	for _, tt := range tests {
+		got := violations(Validate(tt.v))
+		if strings.Join(got, "|") != strings.Join(tt.want, "|") {
+			t.Errorf("%s: violations = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestValidateNestedAndLength(t *testing.T) {
+	type team struct {
+		Name  string   `validate:"required,min=3"`
+		Tags  []string `validate:"max=2"`
+		Owner Person
+	}
+	err := Validate(team{Name: "ab", Tags: []string{"a", "b", "c"}, Owner: Person{Age: 30}})
+	want := []string{
+		"Name length 2 is below min 3",
+		"Tags length 3 is above max 2",
+		"Owner.Name is required", // named nested structs keep their prefix
+	}
+	if got := violations(err); strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("violations = %q, want %q", got, want)
+	}
+
+	if err := Validate(42); err == nil {
+		t.Error("Validate(42) = nil, want an error for a non-struct")
+	}
+}
@@ -0,0 +1,633 @@
+// Package fmtdemo is a tour of the fmt package: verbs, flags, the
+// Stringer and Formatter interfaces, scanning, and error wrapping.
+// Run it with "go run ./cmd/tutorial fmt" from the repository root.
+package fmtdemo
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// Example types to demonstrate fmt interfaces
+type Person struct {
+	Name string
+	Age  int
+}
+
+// String method implements fmt.Stringer interface
+func (p Person) String() string {
+	return fmt.Sprintf("%s (age %d)", p.Name, p.Age)
+}
+
+// Custom type implementing fmt.Formatter
+type Temperature float64
+
+func (t Temperature) Format(f fmt.State, verb rune) {
+	// Custom formatting for temperature
+	switch verb {
+	case 'f', 'v':
+		if verb == 'v' && f.Flag('#') {
+			fmt.Fprintf(f, "Temperature(%v°C)", float64(t))
+			return
+		}
+		// A Formatter receives the flags but must apply them itself:
+		// precision picks the decimals (default 1), width pads the
+		// whole "23.5°C" text, and '-' pads on the right instead
+		prec, ok := f.Precision()
+		if !ok {
+			prec = 1
+		}
+		s := fmt.Sprintf("%.*f°C", prec, float64(t))
+		width, _ := f.Width() // 0 when unset, which pads nothing
+		if f.Flag('-') {
+			fmt.Fprintf(f, "%-*s", width, s)
+		} else {
+			fmt.Fprintf(f, "%*s", width, s)
+		}
+	default:
+		// Fall back to default behavior
+		fmt.Fprintf(f, "%v", float64(t))
+	}
+}
+
+// RGB implements both fmt.Stringer and fmt.GoStringer
+type RGB struct{ R, G, B uint8 }
+
+// String is used by %v and %s
+func (c RGB) String() string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
+
+// GoString is used by %#v and should return valid Go syntax
+func (c RGB) GoString() string {
+	return fmt.Sprintf("color.RGB{R: 0x%02x, G: 0x%02x, B: 0x%02x}", c.R, c.G, c.B)
+}
+
+// ValidationError is a custom error type carrying structured details
+type ValidationError struct {
+	Field string
+	Value interface{}
+}
+
+// Error implements the error interface
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("invalid %s: %v", e.Field, e.Value)
+}
+
+// ErrNotFound is a sentinel error compared with errors.Is
+var ErrNotFound = errors.New("not found")
+
+// Severity ranks how serious a LeveledError is
+type Severity int
+
+const (
+	SeverityWarning Severity = iota + 1 // zero value means "no issues"
+	SeverityError
+	SeverityFatal
+)
+
+// String implements fmt.Stringer for Severity
+func (s Severity) String() string {
+	switch s {
+	case SeverityWarning:
+		return "WARNING"
+	case SeverityError:
+		return "ERROR"
+	case SeverityFatal:
+		return "FATAL"
+	default:
+		return "NONE"
+	}
+}
+
+// LeveledError is an error tagged with a Severity
+type LeveledError struct {
+	Severity Severity
+	Msg      string
+}
+
+// Error implements the error interface
+func (e *LeveledError) Error() string {
+	return fmt.Sprintf("[%v] %s", e.Severity, e.Msg)
+}
+
+// MaxSeverity returns the highest severity found in errs. Wrapped
+// LeveledErrors are found via errors.As; nil entries are skipped and any
+// other error counts as SeverityError, since its seriousness is unknown.
+func MaxSeverity(errs []error) Severity {
+	var highest Severity
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+		level := SeverityError
+		var le *LeveledError
+		if errors.As(err, &le) {
+			level = le.Severity
+		}
+		if level > highest {
+			highest = level
+		}
+	}
+	return highest
+}
+
+// Run prints the whole demo to standard output (section 1 is about
+// Print, Println, and Printf themselves). With interactive set, the
+// scanning section also reads a name, age, and score from stdin.
+func Run(interactive bool) {
+	fmt.Print("=== Go fmt Package Deep Dive ====\n\n")
+
+	// 1. Basic printing functions
+	fmt.Println("1. BASIC PRINTING FUNCTIONS:")
+	fmt.Print("Hello, ")
+	fmt.Print("Go!\n")
+	fmt.Println("Hello, Go!")
+	name := "Alice"
+	age := 30
+	active := true
+	fmt.Printf("Name: %s, Age: %d, Active: %t\n", name, age, active)
+
+	// 2. Format verbs demonstration
+	fmt.Println("\n2. FORMAT VERBS:")
+
+	// General verbs
+	value := 42
+	fmt.Printf("%%v (default): %v\n", value)
+	fmt.Printf("%%+v (with field names): %+v\n", struct {
+		Name string
+		Age  int
+	}{"Bob", 25})
+	fmt.Printf("%%+v on a Stringer (calls String()): %+v\n", Person{Name: "Bob", Age: 25})
+	fmt.Printf("%%#v (Go syntax): %#v\n", value)
+	fmt.Printf("%%T (type): %T\n", value)
+
+	// The same four verbs side by side on identical inputs
+	people := []Person{{"Alice", 30}, {"Bob", 25}}
+	for _, v := range []interface{}{value, people[0], people, map[string]int{"a": 1, "b": 2}} {
+		demoVerbComparison(v, os.Stdout)
+	}
+
+	// Boolean
+	flag := true
+	fmt.Printf("%%t (boolean): %t\n", flag)
+
+	// Integer formatting
+	num := 255
+	fmt.Printf("%%d (decimal): %d\n", num)
+	fmt.Printf("%%b (binary): %b\n", num)
+	fmt.Printf("%%o (octal): %o\n", num)
+	fmt.Printf("%%x (hex): %x\n", num)
+	fmt.Printf("%%X (HEX): %X\n", num)
+	fmt.Printf("%%#x (hex with 0x): %#x\n", num)
+	fmt.Printf("%%U (Unicode): %U\n", num)
+
+	// Floating point
+	pi := 3.14159
+	fmt.Printf("%%f (decimal): %f\n", pi)
+	fmt.Printf("%%.2f (precision): %.2f\n", pi)
+	fmt.Printf("%%e (scientific): %e\n", pi)
+	fmt.Printf("%%g (general): %g\n", pi)
+
+	// String formatting
+	text := "Hello \"World\""
+	fmt.Printf("%%s (string): %s\n", text)
+	fmt.Printf("%%q (quoted): %q\n", text)
+	fmt.Printf("%%x (hex bytes): %x\n", text)
+
+	// Pointer
+	slice := []int{1, 2, 3}
+	fmt.Printf("%%p (pointer): %p\n", slice)
+
+	// 3. Width and precision
+	fmt.Println("\n3. WIDTH AND PRECISION:")
+	fmt.Printf("|%10s|%10d|%10.2f|\n", "hello", 42, 3.14159)
+	fmt.Printf("|%-10s|%-10d|%-10.2f|\n", "hello", 42, 3.14159) // left-justified
+	fmt.Printf("%08d\n", 42)                                    // zero padding
+	fmt.Printf("%8.2f\n", 3.1)                                  // width with precision
+
+	// Fixed widths break on long values; text/tabwriter adapts instead
+	demoTabwriter(os.Stdout)
+
+	// 4. Flags
+	fmt.Println("\n4. FLAGS:")
+	fmt.Printf("Default: %d\n", 42)
+	fmt.Printf("Plus flag: %+d\n", 42)
+	fmt.Printf("Space flag: % d\n", 42)
+	fmt.Printf("Sharp flag: %#o, %#x\n", 42, 42)
+	fmt.Printf("Minus flag: %-10d|\n", 42)
+	fmt.Printf("Zero flag: %010d\n", 42)
+	demoNumberFormatting(os.Stdout)
+
+	// 5. Interface implementations
+	fmt.Println("\n5. INTERFACE IMPLEMENTATIONS:")
+
+	// Stringer interface
+	person := Person{Name: "Charlie", Age: 35}
+	fmt.Printf("Stringer: %v\n", person)
+	fmt.Printf("Stringer with %%s: %s\n", person)
+
+	// Formatter interface
+	temp := Temperature(23.5)
+	fmt.Printf("Formatter (default): %v\n", temp)
+	fmt.Printf("Formatter (float): %f\n", temp)
+	fmt.Printf("Formatter (sharp): %#v\n", temp)
+	fmt.Printf("Formatter (width and precision %%8.3f): |%8.3f|\n", temp)
+	fmt.Printf("Formatter (left-justified %%-10v):    |%-10v|\n", temp)
+
+	// GoStringer interface
+	orange := RGB{R: 255, G: 165, B: 0}
+	fmt.Printf("Stringer (%%v): %v\n", orange)
+	fmt.Printf("GoStringer (%%#v): %#v\n", orange)
+
+	// 6. Error handling in formatting
+	fmt.Println("\n6. ERROR HANDLING:")
+	demoFormatErrors(os.Stdout)
+
+	// 7. Complex types
+	fmt.Println("\n7. COMPLEX TYPES:")
+
+	// Slices and arrays
+	fmt.Printf("Slice: %v\n", []int{1, 2, 3, 4})
+	fmt.Printf("Slice with %%+v: %+v\n", []Person{{Name: "A", Age: 1}, {Name: "B", Age: 2}})
+
+	// Maps
+	m := map[string]int{"apple": 5, "banana": 3}
+	fmt.Printf("Map: %v\n", m)
+	fmt.Printf("Map with %%+v: %+v\n", m)
+
+	// Channels
+	ch := make(chan int)
+	fmt.Printf("Channel: %v\n", ch)
+
+	// Functions
+	funcVar := func(x int) int { return x * 2 }
+	fmt.Printf("Function: %p (funcs print as their address)\n", funcVar)
+
+	// 8. Scanning functions
+	fmt.Println("\n8. SCANNING FUNCTIONS:")
+
+	// Simulate input for scanning
+	input := "Alice 25 3.14"
+	var name2 string
+	var age2 int
+	var score float64
+	n, err := fmt.Sscanf(input, "%s %d %f", &name2, &age2, &score)
+	fmt.Printf("Scanned: name=%s, age=%d, score=%.2f (n=%d, err=%v)\n",
+		name2, age2, score, n, err)
+	demoScanEdgeCases(os.Stdout)
+
+	// Reading real input: go run ./cmd/tutorial fmt -interactive
+	if interactive {
+		demoInteractiveScan(os.Stdin, os.Stdout)
+	} else {
+		fmt.Println("(run with -interactive to scan from stdin)")
+	}
+
+	// 9. Using with io.Writer
+	fmt.Println("\n9. CUSTOM WRITERS:")
+
+	// Write to string buffer
+	var buf strings.Builder
+	n, err = fmt.Fprintf(&buf, "Formatted output: %s scored %.1f points", "Bob", 87.5)
+	fmt.Printf("Wrote %d bytes to buffer: %s\n", n, buf.String())
+
+	// 10. Advanced formatting tricks
+	fmt.Println("\n10. ADVANCED TRICKS:")
+
+	// Argument indexing
+	fmt.Printf("Reordering: %[2]d %[1]s %[3]f\n", "hello", 42, 3.14)
+
+	// Using * for width and precision from arguments
+	width, precision := 8, 3
+	value2 := 3.14159
+	fmt.Printf("Dynamic width/precision: %*.*f\n", width, precision, value2)
+
+	// Format string reconstruction
+	fmt.Printf("Format string reconstruction: %s\n",
+		fmt.Sprintf("%[2]*.[1]*[3]f", precision, width, value2))
+
+	// 11. Performance considerations
+	fmt.Println("\n11. PERFORMANCE:")
+
+	// Reusing format strings vs building strings
+	simple := fmt.Sprintf("Name: %s, Age: %d", "David", 40)
+	fmt.Printf("Sprintf result: %s\n", simple)
+
+	// For simple concatenation, + operator is often faster
+	// (measured in fmt_demo_test.go: go test -bench=. ./fmtdemo)
+	fast := "Name: " + "David" + ", Age: " + fmt.Sprintf("%d", 40)
+	fmt.Printf("Concatenation result: %s\n", fast)
+
+	// 12. Type reflection with fmt
+	fmt.Println("\n12. TYPE REFLECTION:")
+
+	var x interface{} = 42
+	fmt.Printf("Type of x: %T\n", x)
+	fmt.Printf("Value of x: %v\n", x)
+
+	x = "hello"
+	fmt.Printf("Type of x: %T\n", x)
+	fmt.Printf("Value of x: %v\n", x)
+
+	x = []int{1, 2, 3}
+	fmt.Printf("Type of x: %T\n", x)
+	fmt.Printf("Value of x: %v\n", x)
+
+	// Demonstrate reflect.Value formatting
+	val := reflect.ValueOf([]int{10, 20, 30})
+	fmt.Printf("reflect.Value: %v\n", val)
+
+	// 13. Error wrapping with %w
+	fmt.Println("\n13. ERROR WRAPPING:")
+	demoErrorWrapping(os.Stdout)
+	demoLeveledErrors(os.Stdout)
+
+	// 14. Human-readable sizes and durations
+	fmt.Println("\n14. HUMAN-READABLE VALUES:")
+	demoHumanReadable(os.Stdout)
+
+	fmt.Println("\n=== End of fmt Package Demo ====")
+}
+
+// demoFormatErrors shows how fmt reports bad format strings in its output
+// instead of panicking. Every call below is INTENTIONALLY wrong.
+func demoFormatErrors(w io.Writer) {
+	fmt.Fprintln(w, "*** INTENTIONAL ERRORS: each line below misuses a verb on purpose ***")
+	fmt.Fprintln(w, "*** fmt never panics; it embeds an error marker in the output    ***")
+
+	// The formats live in a table so `go vet` doesn't flag the (intended) bugs
+	mistakes := []struct {
+		label    string
+		format   string
+		args     []interface{}
+		expected string
+	}{
+		{"Wrong type", "%d", []interface{}{"hello"}, "%!d(string=hello)"},
+		{"Missing arg", "%d %s", []interface{}{42}, "%!s(MISSING)"},
+		{"Extra arg", "%d", []interface{}{42, "extra"}, "%!(EXTRA string=extra)"},
+		{"Bad verb", "%z", []interface{}{42}, "%!z(int=42)"},
+	}
+
+	for _, m := range mistakes {
+		got := fmt.Sprintf(m.format, m.args...)
+		fmt.Fprintf(w, "  %-12s %-8q → %-28s (expected marker: %s)\n",
+			m.label+":", m.format, got, m.expected)
+	}
+}
+
+// demoErrorWrapping shows %w wrapping and unwrapping with errors.Is/As
+func demoErrorWrapping(w io.Writer) {
+	// %w wraps the original error; %v would only copy its text
+	base := &ValidationError{Field: "age", Value: -5}
+	wrapped := fmt.Errorf("saving user: %w", base)
+	fmt.Fprintf(w, "Wrapped error: %v\n", wrapped)
+	fmt.Fprintf(w, "errors.Unwrap: %v\n", errors.Unwrap(wrapped))
+
+	// errors.As finds a specific error TYPE anywhere in the chain
+	var ve *ValidationError
+	if errors.As(wrapped, &ve) {
+		fmt.Fprintf(w, "errors.As found ValidationError: field=%s value=%v\n", ve.Field, ve.Value)
+	}
+
+	// errors.Is compares against a specific error VALUE (sentinel)
+	lookup := fmt.Errorf("loading config: %w", ErrNotFound)
+	fmt.Fprintf(w, "errors.Is(lookup, ErrNotFound): %t\n", errors.Is(lookup, ErrNotFound))
+	fmt.Fprintf(w, "errors.Is(wrapped, ErrNotFound): %t\n", errors.Is(wrapped, ErrNotFound))
+
+	// %v breaks the chain: the text survives but the error does not
+	flattened := fmt.Errorf("saving user: %v", base)
+	fmt.Fprintf(w, "With %%v instead of %%w, errors.As finds it? %t\n", errors.As(flattened, &ve))
+
+	// Go 1.20+: multiple %w verbs wrap several errors at once
+	multi := fmt.Errorf("request failed: %w; %w", base, ErrNotFound)
+	fmt.Fprintf(w, "Multi-wrap: %v\n", multi)
+	fmt.Fprintf(w, "  Is ErrNotFound? %t, As ValidationError? %t\n",
+		errors.Is(multi, ErrNotFound), errors.As(multi, &ve))
+
+	// errors.Join combines errors, one per line when printed
+	joined := errors.Join(
+		&ValidationError{Field: "name", Value: ""},
+		&ValidationError{Field: "email", Value: "not-an-email"},
+	)
+	fmt.Fprintf(w, "errors.Join:\n%v\n", joined)
+}
+
+// demoTabwriter contrasts fixed-width verbs with text/tabwriter alignment
+func demoTabwriter(w io.Writer) {
+	rows := []struct {
+		Name string
+		Age  int
+		City string
+	}{
+		{"Al", 7, "Rome"},
+		{"Bartholomew Montgomery", 42, "San Francisco"},
+		{"Eve", 103, "Oslo"},
+	}
+
+	// Brittle: %-10s pads to 10 but never truncates, so long names push columns
+	fmt.Fprintln(w, "Fixed width (left-justified to 10 columns):")
+	for _, r := range rows {
+		fmt.Fprintf(w, "  %-10s %-4d %s\n", r.Name, r.Age, r.City)
+	}
+
+	// tabwriter buffers tab-separated cells and sizes each column to fit
+	// its widest cell. Flush() is required to emit the buffered output.
+	fmt.Fprintln(w, "text/tabwriter (tab-separated cells):")
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "  NAME\tAGE\tCITY")
+	for _, r := range rows {
+		fmt.Fprintf(tw, "  %s\t%d\t%s\n", r.Name, r.Age, r.City)
+	}
+	tw.Flush()
+
+	// AlignRight right-aligns cells (handy for numbers); note the trailing \t
+	fmt.Fprintln(w, "text/tabwriter with AlignRight:")
+	tw = tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	for _, r := range rows {
+		fmt.Fprintf(tw, "%s\t%d\t%s\t\n", r.Name, r.Age, r.City)
+	}
+	tw.Flush()
+}
+
+// demoNumberFormatting shows the flags on negative, zero, and oversized
+// numbers, where their behavior is less obvious than on 42
+func demoNumberFormatting(w io.Writer) {
+	fmt.Fprintln(w, "Sign flags (+ always shows a sign, space reserves one):")
+	for _, n := range []int{42, 0, -42} {
+		fmt.Fprintf(w, "  %%d=%-4d %%+d=%+-4d %% d=% -4d|\n", n, n, n)
+	}
+
+	// Zero padding goes AFTER the sign, and the sign counts toward the width
+	fmt.Fprintln(w, "Zero padding with a sign:")
+	fmt.Fprintf(w, "  %%05d on -42:  %05d\n", -42)
+	fmt.Fprintf(w, "  %%+05d on 42:  %+05d\n", 42)
+	fmt.Fprintf(w, "  %%+05d on -42: %+05d\n", -42)
+	fmt.Fprintf(w, "  %%05d on 0:    %05d\n", 0)
+
+	// Width is a minimum: a wider number is never truncated
+	fmt.Fprintf(w, "Too wide for the field: |%5d| |%05d|\n", 1234567, -1234567)
+
+	fmt.Fprintln(w, "Alignment of -42 in a width of 8:")
+	fmt.Fprintf(w, "  right |%8d|  left |%-8d|  zero |%08d|\n", -42, -42, -42)
+
+	// fmt has no thousands separator; insert it by hand
+	// (golang.org/x/text/message does it per locale)
+	fmt.Fprintln(w, "Thousands grouping:")
+	for _, n := range []int64{0, 999, 1000, -1234567, 9876543210} {
+		fmt.Fprintf(w, "  %14d -> %15s\n", n, groupThousands(n))
+	}
+}
+
+// groupThousands formats n with a comma between every three digits
+func groupThousands(n int64) string {
+	s := strconv.FormatInt(n, 10)
+	sign := ""
+	if s[0] == '-' {
+		sign, s = "-", s[1:]
+	}
+	var sb strings.Builder
+	for i, c := range s {
+		if i > 0 && (len(s)-i)%3 == 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteRune(c)
+	}
+	return sign + sb.String()
+}
+
+// formatBytes formats n using binary (1024-based) units, e.g. "1.5 KiB".
+// Values below 1 KiB are printed exactly: "512 B".
+func formatBytes(n int64) string {
+	const unit = 1024
+	// A range check rather than negating n: -math.MinInt64 overflows
+	if n > -unit && n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	// Divide while the value would print as "1024.0" or more, so that
+	// 1048575 becomes "1.0 MiB" rather than "1024.0 KiB"
+	value, i := float64(n), -1
+	for math.Abs(value) >= unit-0.05 && i < len("KMGTPE")-1 {
+		value /= unit
+		i++
+	}
+	return fmt.Sprintf("%.1f %ciB", value, "KMGTPE"[i])
+}
+
+// demoHumanReadable formats byte counts with formatBytes and durations
+// with time.Duration's String method
+func demoHumanReadable(w io.Writer) {
+	fmt.Fprintln(w, "Byte sizes:")
+	for _, n := range []int64{0, 512, 1023, 1024, 1536, 2411724, 5 << 30, -1536} {
+		fmt.Fprintf(w, "  %12d -> %s\n", n, formatBytes(n))
+	}
+
+	// Duration already implements Stringer, so %v and %s pick the unit
+	fmt.Fprintln(w, "Durations (time.Duration's String method):")
+	for _, d := range []time.Duration{
+		0,
+		1500 * time.Microsecond,
+		2*time.Second + 300*time.Millisecond,
+		90 * time.Minute,
+		-45 * time.Second,
+	} {
+		fmt.Fprintf(w, "  %20d ns -> %v\n", int64(d), d)
+	}
+	// Round/Truncate trim noisy precision before printing
+	d := 3*time.Second + 141592653*time.Nanosecond
+	fmt.Fprintf(w, "  %v rounded to ms: %v\n", d, d.Round(time.Millisecond))
+}
+
+// demoVerbComparison prints v with %v, %+v, %#v, and %T in aligned rows.
+// Note that Person's String() method is used by %v and %+v but not %#v.
+func demoVerbComparison(v interface{}, w io.Writer) {
+	fmt.Fprintln(w, "  ---")
+	for _, verb := range []string{"%v", "%+v", "%#v", "%T"} {
+		fmt.Fprintf(w, "  %-4s "+verb+"\n", verb, v)
+	}
+}
+
+// demoLeveledErrors collects validation issues of mixed severity
+func demoLeveledErrors(w io.Writer) {
+	issues := []error{
+		&LeveledError{Severity: SeverityWarning, Msg: "nickname is empty"},
+		nil, // a check that passed
+		fmt.Errorf("checking email: %w", &LeveledError{Severity: SeverityError, Msg: "missing @"}),
+		&LeveledError{Severity: SeverityWarning, Msg: "age not set"},
+	}
+
+	fmt.Fprintln(w, "Collected validation issues:")
+	for _, err := range issues {
+		if err != nil {
+			fmt.Fprintf(w, "  %v\n", err)
+		}
+	}
+	fmt.Fprintf(w, "MaxSeverity: %v\n", MaxSeverity(issues))
+	fmt.Fprintf(w, "MaxSeverity(warnings only): %v\n", MaxSeverity(issues[:2]))
+	fmt.Fprintf(w, "MaxSeverity(plain error): %v\n", MaxSeverity([]error{errors.New("disk full")}))
+	fmt.Fprintf(w, "MaxSeverity(none): %v\n", MaxSeverity(nil))
+}
+
+// demoInteractiveScan reads a name, age, and score from r. Fscan treats
+// newlines as spaces, so the values may be on one line or several.
+// On partial input n reports how many values were stored before err.
+func demoInteractiveScan(r io.Reader, w io.Writer) (int, error) {
+	var name string
+	var age int
+	var score float64
+
+	fmt.Fprint(w, "Enter name, age, and score (e.g. Alice 25 3.14): ")
+	n, err := fmt.Fscan(r, &name, &age, &score)
+	if err != nil {
+		fmt.Fprintf(w, "\nPartial scan: %d of 3 values read (err=%v)\n", n, err)
+		fmt.Fprintf(w, "  name=%q, age=%d, score=%.2f\n", name, age, score)
+		return n, err
+	}
+
+	fmt.Fprintf(w, "\nScanned: name=%s, age=%d, score=%.2f (n=%d)\n", name, age, score, n)
+	return n, nil
+}
+
+// demoScanEdgeCases shows the (n, err) contract of the Sscan family:
+// n counts the values stored before scanning stopped, and err says why
+func demoScanEdgeCases(w io.Writer) {
+	var name string
+	var age int
+	var score float64
+
+	// Malformed input: "abc" isn't an int, so scanning stops at age.
+	// name was already stored; age and score keep their previous values.
+	n, err := fmt.Sscanf("Bob abc 2.5", "%s %d %f", &name, &age, &score)
+	fmt.Fprintf(w, "Sscanf(\"Bob abc 2.5\"): n=%d, err=%v\n", n, err)
+	fmt.Fprintf(w, "  name=%q stored; age=%d, score=%.1f untouched\n", name, age, score)
+
+	// Too little input: input runs out before the format does, err is EOF
+	n, err = fmt.Sscanf("Carol 31", "%s %d %f", &name, &age, &score)
+	fmt.Fprintf(w, "Sscanf(\"Carol 31\"): n=%d, err=%v\n", n, err)
+
+	// Extra input is NOT an error: Sscanf stops when the format ends
+	n, err = fmt.Sscanf("Dave 40 9.5 leftover", "%s %d %f", &name, &age, &score)
+	fmt.Fprintf(w, "Sscanf(\"Dave 40 9.5 leftover\"): n=%d, err=%v (\"leftover\" ignored)\n", n, err)
+
+	// Sscan needs no format: it splits on spaces (and newlines) and
+	// parses each field according to the argument's type
+	n, err = fmt.Sscan("Eve\n28 7.25", &name, &age, &score)
+	fmt.Fprintf(w, "Sscan(\"Eve\\n28 7.25\"): n=%d, err=%v → %s %d %.2f\n", n, err, name, age, score)
+
+	// Scanning into a slice: pass a pointer to each element
+	nums := make([]int, 4)
+	ptrs := make([]interface{}, len(nums))
+	for i := range nums {
+		ptrs[i] = &nums[i]
+	}
+	n, err = fmt.Sscan("10 20 30", ptrs...)
+	fmt.Fprintf(w, "Sscan(\"10 20 30\") into 4 slots: n=%d, err=%v, nums=%v\n", n, err, nums)
+}
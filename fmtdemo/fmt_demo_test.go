@@ -0,0 +1,188 @@
+package fmtdemo
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestVerbComparisonGoSyntax(t *testing.T) {
+	var sb strings.Builder
+	demoVerbComparison(Person{Name: "Alice", Age: 30}, &sb)
+
+	for _, line := range strings.Split(sb.String(), "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "%#v") {
+			if want := `fmtdemo.Person{Name:"Alice", Age:30}`; !strings.Contains(line, want) {
+				t.Errorf("%%#v line = %q, want it to contain %q", line, want)
+			}
+			return
+		}
+	}
+	t.Fatalf("no %%#v line in output:\n%s", sb.String())
+}
+
+func TestFormatBytes(t *testing.T) {
+	tests := []struct {
+		n    int64
+		want string
+	}{
+		{0, "0 B"},
+		{1023, "1023 B"},
+		{1024, "1.0 KiB"},
+		{1536, "1.5 KiB"},
+		{1048575, "1.0 MiB"},
+		{1048576, "1.0 MiB"},
+		{1 << 30, "1.0 GiB"},
+		{-1536, "-1.5 KiB"},
+		{-1023, "-1023 B"},
+		{-1024, "-1.0 KiB"},
+		{math.MaxInt64, "8.0 EiB"},
+		{math.MinInt64, "-8.0 EiB"},
+	}
+	for _, tt := range tests {
+		if got := formatBytes(tt.n); got != tt.want {
+			t.Errorf("formatBytes(%d) = %q, want %q", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestTemperatureFormatFlags(t *testing.T) {
+	temp := Temperature(23.5)
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{"%v", "23.5°C"},
+		{"%f", "23.5°C"},
+		{"%.3f", "23.500°C"},
+		{"%.0f", "24°C"},
+		{"%8.3f", "23.500°C"},
+		{"%10.2f", "   23.50°C"},
+		{"%-10v", "23.5°C    "},
+	}
+	for _, tt := range tests {
+		if got := fmt.Sprintf(tt.format, temp); got != tt.want {
+			t.Errorf("Sprintf(%q) = %q, want %q", tt.format, got, tt.want)
+		}
+	}
+}
+
+// String-building benchmarks backing section 11 (PERFORMANCE) of
+// fmt_demo.go. Each builds the same "Name: X, Age: N" string.
+//
+//	go test -bench=. -benchmem ./fmtdemo
+//
+// Typical results: Sprintf is 2-3x slower than the other two and makes
+// 2 allocs/op (it parses the format and boxes the arguments), while Concat
+// and Builder (with Grow) are close to each other at 1 alloc/op.
+//
+// So + really is faster for a handful of pieces; strings.Builder wins when
+// appending in a loop, where + would copy the whole string every time.
+
+var (
+	benchName = "David"
+	benchAge  = 40
+	benchSink string // keeps the compiler from discarding the result
+)
+
+func BenchmarkSprintf(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchSink = fmt.Sprintf("Name: %s, Age: %d", benchName, benchAge)
+	}
+}
+
+func BenchmarkConcat(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchSink = "Name: " + benchName + ", Age: " + strconv.Itoa(benchAge)
+	}
+}
+
+func BenchmarkBuilder(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var sb strings.Builder
+		sb.Grow(32)
+		sb.WriteString("Name: ")
+		sb.WriteString(benchName)
+		sb.WriteString(", Age: ")
+		sb.WriteString(strconv.Itoa(benchAge))
+		benchSink = sb.String()
+	}
+}
+
+func TestRGBGoStringer(t *testing.T) {
+	orange := RGB{R: 255, G: 165, B: 0}
+	tests := []struct {
+		format string
+		arg    any
+		want   string
+	}{
+		{"%#v", orange, "color.RGB{R: 0xff, G: 0xa5, B: 0x00}"},
+		{"%v", orange, "#ffa500"},
+		{"%s", orange, "#ffa500"},
+		// GoString is used for elements of a %#v'd slice too
+		{"%#v", []RGB{{}}, "[]fmtdemo.RGB{color.RGB{R: 0x00, G: 0x00, B: 0x00}}"},
+	}
+	for _, tt := range tests {
+		if got := fmt.Sprintf(tt.format, tt.arg); got != tt.want {
+			t.Errorf("Sprintf(%q, %v) = %q, want %q", tt.format, tt.arg, got, tt.want)
+		}
+	}
+}
+
+func TestMaxSeverity(t *testing.T) {
+	warn := &LeveledError{Severity: SeverityWarning, Msg: "w"}
+	errLvl := &LeveledError{Severity: SeverityError, Msg: "e"}
+	fatal := &LeveledError{Severity: SeverityFatal, Msg: "f"}
+
+	tests := []struct {
+		name string
+		errs []error
+		want Severity
+	}{
+		{"none", nil, 0},
+		{"only nil entries", []error{nil, nil}, 0},
+		{"single warning", []error{warn}, SeverityWarning},
+		{"mixed, fatal in the middle", []error{warn, fatal, errLvl}, SeverityFatal},
+		{"mixed, highest last", []error{warn, warn, errLvl}, SeverityError},
+		{"wrapped", []error{warn, fmt.Errorf("ctx: %w", fatal)}, SeverityFatal},
+		// Plain errors have no level; they count as SeverityError
+		{"plain error only", []error{ErrNotFound}, SeverityError},
+		{"plain error beats warning", []error{warn, ErrNotFound}, SeverityError},
+		{"plain error below fatal", []error{ErrNotFound, fatal, nil}, SeverityFatal},
+	}
+	for _, tt := range tests {
+		if got := MaxSeverity(tt.errs); got != tt.want {
+			t.Errorf("%s: MaxSeverity = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestInteractiveScan(t *testing.T) {
+	tests := []struct {
+		input   string
+		wantN   int
+		wantErr bool
+		want    string // expected in the output
+	}{
+		{"Alice 25 3.14\n", 3, false, "name=Alice, age=25, score=3.14"},
+		{"Bob\n40\n2.5\n", 3, false, "name=Bob, age=40, score=2.50"}, // newlines count as spaces
+		{"Carol 31", 2, true, `name="Carol", age=31`},                // input runs out
+		{"Dave abc 1.0", 1, true, `name="Dave", age=0`},              // age isn't a number
+		{"", 0, true, "0 of 3 values read"},
+	}
+	for _, tt := range tests {
+		var out strings.Builder
+		n, err := demoInteractiveScan(strings.NewReader(tt.input), &out)
+		if n != tt.wantN || (err != nil) != tt.wantErr {
+			t.Errorf("scan %q: n=%d, err=%v; want n=%d, error=%v", tt.input, n, err, tt.wantN, tt.wantErr)
+		}
+		if !strings.Contains(out.String(), tt.want) {
+			t.Errorf("scan %q: output %q does not contain %q", tt.input, out.String(), tt.want)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package functions
+
+import (
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// ChannelSelect shows the everyday select patterns: a non-blocking
+// receive, a timeout, fan-in, and what select does with a closed channel
+func ChannelSelect(w io.Writer) {
+	fmt.Fprintln(w, "1. Non-blocking receive with default:")
+	ch := make(chan int, 1)
+	v, received, closed := tryReceive(ch)
+	fmt.Fprintf(w, "  empty channel: value=%d received=%v closed=%v (default ran, nothing waited)\n",
+		v, received, closed)
+	ch <- 42
+	v, received, closed = tryReceive(ch)
+	fmt.Fprintf(w, "  after ch <- 42: value=%d received=%v closed=%v\n", v, received, closed)
+	close(ch)
+	v, received, closed = tryReceive(ch)
+	fmt.Fprintf(w, "  after close(ch): value=%d received=%v closed=%v\n", v, received, closed)
+
+	fmt.Fprintln(w, "\n2. Timeout with time.After:")
+	for _, delay := range []time.Duration{10 * time.Millisecond, 80 * time.Millisecond} {
+		if v, err := receiveWithTimeout(slowValue(delay), 40*time.Millisecond); err != nil {
+			fmt.Fprintf(w, "  reply after %v: %v\n", delay, err)
+		} else {
+			fmt.Fprintf(w, "  reply after %v: got %q\n", delay, v)
+		}
+	}
+
+	fmt.Fprintln(w, "\n3. Fan-in - one channel fed by two producers:")
+	evens, odds := make(chan int), make(chan int)
+	go produce(evens, 0, 2, 3)
+	go produce(odds, 1, 2, 3)
+	var got []int
+	for v := range merge(evens, odds) {
+		got = append(got, v)
+	}
+	fmt.Fprintf(w, "  merged %d values: %v (order depends on scheduling)\n", len(got), got)
+
+	fmt.Fprintln(w, "\n4. A closed channel is always ready:")
+	done := make(chan int)
+	close(done)
+	for i := 0; i < 2; i++ {
+		select {
+		case v, ok := <-done:
+			fmt.Fprintf(w, "  receive #%d: value=%d ok=%v (zero value, immediately)\n", i+1, v, ok)
+		default:
+			fmt.Fprintln(w, "  default never runs for a closed channel")
+		}
+	}
+	fmt.Fprintln(w, "  (in a loop, check ok or set the channel to nil - a nil channel is never ready)")
+}
+
+// tryReceive returns the next value from ch if one is ready right now,
+// without blocking. received reports whether a value arrived; closed
+// tells a closed and drained channel apart from one that is just empty.
+func tryReceive(ch <-chan int) (v int, received, closed bool) {
+	select {
+	case v, ok := <-ch:
+		return v, ok, !ok
+	default:
+		return 0, false, false
+	}
+}
+
+// receiveWithTimeout waits at most d for a value from ch
+func receiveWithTimeout(ch <-chan string, d time.Duration) (string, error) {
+	select {
+	case v := <-ch:
+		return v, nil
+	case <-time.After(d):
+		return "", fmt.Errorf("timed out after %v", d)
+	}
+}
+
+// slowValue sends one value after delay. The channel is buffered so the
+// goroutine can still exit if the receiver timed out and left.
+func slowValue(delay time.Duration) <-chan string {
+	ch := make(chan string, 1)
+	go func() {
+		time.Sleep(delay)
+		ch <- "done"
+	}()
+	return ch
+}
+
+// produce sends count values start, start+step, ... and closes ch
+func produce(ch chan<- int, start, step, count int) {
+	for i := 0; i < count; i++ {
+		ch <- start + i*step
+	}
+	close(ch)
+}
+
+// merge forwards every value from a and b to one channel, which is
+// closed once both inputs are closed and drained
+func merge(a, b <-chan int) <-chan int {
+	out := make(chan int)
+	var wg sync.WaitGroup
+	for _, in := range []<-chan int{a, b} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for v := range in {
+				out <- v
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
@@ -0,0 +1,51 @@
+package functions
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestTryReceiveEmpty(t *testing.T) {
+	ch := make(chan int) // unbuffered with no sender: a plain receive would block forever
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if v, received, closed := tryReceive(ch); received || closed || v != 0 {
+			t.Errorf("tryReceive(empty) = %d, %v, %v; want 0, false, false", v, received, closed)
+		}
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("tryReceive blocked on an empty channel")
+	}
+}
+
+func TestTryReceiveReadyAndClosed(t *testing.T) {
+	ch := make(chan int, 1)
+	ch <- 7
+	close(ch) // a value still buffered is received before the close shows
+	if v, received, closed := tryReceive(ch); !received || closed || v != 7 {
+		t.Errorf("tryReceive(ready) = %d, %v, %v; want 7, true, false", v, received, closed)
+	}
+	for i := 0; i < 2; i++ { // and a closed channel stays that way
+		if v, received, closed := tryReceive(ch); received || !closed || v != 0 {
+			t.Errorf("tryReceive(closed) = %d, %v, %v; want 0, false, true", v, received, closed)
+		}
+	}
+}
+
+func TestMerge(t *testing.T) {
+	a, b := make(chan int), make(chan int)
+	go produce(a, 0, 2, 3)
+	go produce(b, 1, 2, 3)
+	var got []int
+	for v := range merge(a, b) {
+		got = append(got, v)
+	}
+	slices.Sort(got)
+	if want := []int{0, 1, 2, 3, 4, 5}; !slices.Equal(got, want) {
+		t.Errorf("merge = %v, want %v in any order", got, want)
+	}
+}
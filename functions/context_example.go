@@ -0,0 +1,62 @@
+package functions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+)
+
+// RunContextDemo runs the same long task three ways: to completion,
+// cancelled by the caller, and stopped by a timeout
+func RunContextDemo(w io.Writer) {
+	fmt.Fprintln(w, "\n1. No cancellation - the task finishes:")
+	report(w, runTask(context.Background(), w, 3))
+
+	fmt.Fprintln(w, "\n2. context.WithCancel - the caller stops the task:")
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(35*time.Millisecond, cancel) // e.g. the user hit Ctrl-C
+	report(w, runTask(ctx, w, 10))
+	cancel() // always release the context, even if it already fired
+
+	fmt.Fprintln(w, "\n3. context.WithTimeout - the task runs out of time:")
+	ctx, cancel = context.WithTimeout(context.Background(), 55*time.Millisecond)
+	defer cancel()
+	report(w, runTask(ctx, w, 10))
+}
+
+// runTask starts a goroutine doing steps units of work and waits for it.
+// The goroutine checks ctx.Done() between steps, so it stops promptly
+// instead of running on after nobody wants the result.
+func runTask(ctx context.Context, w io.Writer, steps int) error {
+	done := make(chan error, 1) // buffered: the goroutine never blocks on send
+
+	go func() {
+		for i := 1; i <= steps; i++ {
+			select {
+			case <-ctx.Done():
+				done <- ctx.Err() // context.Canceled or context.DeadlineExceeded
+				return
+			case <-time.After(20 * time.Millisecond): // one unit of work
+				fmt.Fprintf(w, "  step %d/%d done\n", i, steps)
+			}
+		}
+		done <- nil
+	}()
+
+	return <-done
+}
+
+func report(w io.Writer, err error) {
+	switch {
+	case err == nil:
+		fmt.Fprintln(w, "  → finished")
+	case errors.Is(err, context.DeadlineExceeded):
+		fmt.Fprintf(w, "  → timed out: %v\n", err)
+	case errors.Is(err, context.Canceled):
+		fmt.Fprintf(w, "  → cancelled: %v\n", err)
+	default:
+		fmt.Fprintf(w, "  → failed: %v\n", err)
+	}
+}
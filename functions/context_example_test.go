@@ -0,0 +1,71 @@
+package functions
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRunTaskTimeout(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+	defer cancel()
+
+	var log strings.Builder
+	start := time.Now()
+	err := runTask(ctx, &log, 50) // 50 steps of 20ms would take a second
+	elapsed := time.Since(start)
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("runTask with a 30ms timeout = %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed > 500*time.Millisecond {
+		t.Errorf("runTask returned after %v, want it to stop soon after the timeout", elapsed)
+	}
+	if strings.Contains(log.String(), "step 50/50") {
+		t.Errorf("task ran to completion despite the timeout:\n%s", log.String())
+	}
+}
+
+func TestRunTaskCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel() // cancelled before the task even starts
+
+	var log strings.Builder
+	if err := runTask(ctx, &log, 5); !errors.Is(err, context.Canceled) {
+		t.Errorf("runTask with a cancelled context = %v, want context.Canceled", err)
+	}
+	if log.Len() != 0 {
+		t.Errorf("cancelled task still did work:\n%s", log.String())
+	}
+}
+
+func TestRunTaskFinishes(t *testing.T) {
+	var log strings.Builder
+	if err := runTask(context.Background(), &log, 2); err != nil {
+		t.Errorf("runTask without cancellation = %v, want nil", err)
+	}
+	if want := "  step 1/2 done\n  step 2/2 done\n"; log.String() != want {
+		t.Errorf("log = %q, want %q", log.String(), want)
+	}
+}
+
+func TestReportCancellationPaths(t *testing.T) {
+	tests := []struct {
+		err  error
+		want string
+	}{
+		{nil, "→ finished"},
+		{context.DeadlineExceeded, "→ timed out"},
+		{context.Canceled, "→ cancelled"},
+		{errors.New("disk full"), "→ failed: disk full"},
+	}
+	for _, tt := range tests {
+		var sb strings.Builder
+		report(&sb, tt.err)
+		if !strings.Contains(sb.String(), tt.want) {
+			t.Errorf("report(%v) = %q, want it to contain %q", tt.err, sb.String(), tt.want)
+		}
+	}
+}
@@ -1,66 +1,84 @@
-package main
+package functions
 
 import (
 	"fmt"
+	"io"
 	"os"
+	"strconv"
+	"sync"
 	"time"
 )
 
-func main() {
-	fmt.Println("=== Defer Examples ===\n")
-
+// RunDefer runs the defer, panic, and recover examples
+func RunDefer(w io.Writer) {
 	// Example 1: Simple defer - executes in LIFO order
-	fmt.Println("1. Simple defer - LIFO order:")
-	simpleDeferExample()
+	fmt.Fprintln(w, "1. Simple defer - LIFO order:")
+	simpleDeferExample(w)
 
 	// Example 2: File operations with defer
-	fmt.Println("\n2. File operations with defer:")
-	fileDeferExample()
+	fmt.Fprintln(w, "\n2. File operations with defer:")
+	fileDeferExample(w)
 
 	// Example 3: Multiple defers for resource cleanup
-	fmt.Println("\n3. Resource cleanup with multiple defers:")
-	resourceCleanupExample()
+	fmt.Fprintln(w, "\n3. Resource cleanup with multiple defers:")
+	resourceCleanupExample(w)
 
 	// Example 4: Defer with function parameters
-	fmt.Println("\n4. Defer with function parameters:")
-	deferWithParametersExample()
+	fmt.Fprintln(w, "\n4. Defer with function parameters:")
+	deferWithParametersExample(w)
 
 	// Example 5: Defer for recovery from panic
-	fmt.Println("\n5. Panic recovery with defer:")
-	panicRecoveryExample()
+	fmt.Fprintln(w, "\n5. Panic recovery with defer:")
+	panicRecoveryExample(w)
+
+	// Example 6: Recovering panics inside goroutines
+	fmt.Fprintln(w, "\n6. Panic-safe goroutine launcher:")
+	safeGoroutineExample(w)
+
+	// Example 7: Turning a panic into an error
+	fmt.Fprintln(w, "\n7. Recover into a returned error:")
+	safeCallExample(w)
+
+	// Example 8: Timing a function with a single defer
+	fmt.Fprintln(w, "\n8. Defer-based trace/timing helper:")
+	traceExample(w)
+
+	// Example 9: Recovering only the panics you expect
+	fmt.Fprintln(w, "\n9. Selective recover (re-panic on unexpected values):")
+	recoverOnlyExample(w)
 }
 
-func simpleDeferExample() {
-	fmt.Println("Function started")
-	
-	defer fmt.Println("First defer (executed last)")
-	defer fmt.Println("Second defer")
-	defer fmt.Println("Third defer (executed first)")
-	defer fmt.Println("Third defer (executed firsttttt)")
-	
-	fmt.Println("Function ending")
+func simpleDeferExample(w io.Writer) {
+	fmt.Fprintln(w, "Function started")
+
+	defer fmt.Fprintln(w, "First defer (executed last)")
+	defer fmt.Fprintln(w, "Second defer")
+	defer fmt.Fprintln(w, "Third defer (executed first)")
+	defer fmt.Fprintln(w, "Third defer (executed firsttttt)")
+
+	fmt.Fprintln(w, "Function ending")
 }
 
-func fileDeferExample() {
+func fileDeferExample(w io.Writer) {
 	// Create a test file
 	filename := "test.txt"
 	content := "Hello, Go defer!"
-	
+
 	// Write to file
 	err := os.WriteFile(filename, []byte(content), 0644)
 	if err != nil {
-		fmt.Printf("Error creating file: %v\n", err)
+		fmt.Fprintf(w, "Error creating file: %v\n", err)
 		return
 	}
 
 	// Read file with defer for closing
 	file, err := os.Open(filename)
 	if err != nil {
-		fmt.Printf("Error opening file: %v\n", err)
+		fmt.Fprintf(w, "Error opening file: %v\n", err)
 		return
 	}
 	defer func() {
-		fmt.Println("Closing file...")
+		fmt.Fprintln(w, "Closing file...")
 		file.Close()
 	}()
 
@@ -68,65 +86,222 @@ func fileDeferExample() {
 	buffer := make([]byte, 1024)
 	n, err := file.Read(buffer)
 	if err != nil {
-		fmt.Printf("Error reading file: %v\n", err)
+		fmt.Fprintf(w, "Error reading file: %v\n", err)
 		return
 	}
 
-	fmt.Printf("File content: %s\n", string(buffer[:n]))
+	fmt.Fprintf(w, "File content: %s\n", string(buffer[:n]))
 }
 
-func resourceCleanupExample() {
-	fmt.Println("Setting up resources...")
-	
+func resourceCleanupExample(w io.Writer) {
+	fmt.Fprintln(w, "Setting up resources...")
+
 	// Simulate database connection
 	defer func() {
-		fmt.Println("Closing database connection...")
+		fmt.Fprintln(w, "Closing database connection...")
 	}()
-	
+
 	// Simulate file handle
 	defer func() {
-		fmt.Println("Closing file handle...")
+		fmt.Fprintln(w, "Closing file handle...")
 	}()
-	
+
 	// Simulate network connection
 	defer func() {
-		fmt.Println("Closing network connection...")
+		fmt.Fprintln(w, "Closing network connection...")
 	}()
-	
-	fmt.Println("Working with resources...")
+
+	fmt.Fprintln(w, "Working with resources...")
 	time.Sleep(100 * time.Millisecond)
-	fmt.Println("Done with resources")
+	fmt.Fprintln(w, "Done with resources")
 }
 
-func deferWithParametersExample() {
+func deferWithParametersExample(w io.Writer) {
 	message := "Original message"
-	
+
 	// The parameter is evaluated immediately, but execution is deferred
-	defer printMessage(message)
-	
+	defer printMessage(message, w)
+
 	message = "Changed message"
-	
-	fmt.Println("Inside function, after defer setup")
+
+	fmt.Fprintln(w, "Inside function, after defer setup")
 }
 
-func printMessage(msg string) {
-	fmt.Printf("Deferred message: %s\n", msg)
+func printMessage(msg string, w io.Writer) {
+	fmt.Fprintf(w, "Deferred message: %s\n", msg)
 }
 
-func panicRecoveryExample() {
+func panicRecoveryExample(w io.Writer) {
 	safeFunction := func() {
 		defer func() {
 			if r := recover(); r != nil {
-				fmt.Printf("Recovered from panic: %v\n", r)
+				fmt.Fprintf(w, "Recovered from panic: %v\n", r)
 			}
 		}()
 
-		fmt.Println("About to cause a panic...")
+		fmt.Fprintln(w, "About to cause a panic...")
 		panic("Something went wrong!")
-		// This line will never be reached
-		fmt.Println("This will not print")
+		// Anything after panic would never run (go vet reports it as
+		// unreachable code)
 	}
 
 	safeFunction()
-	fmt.Println("Program continues after recovery")
-}
\ No newline at end of file
+	fmt.Fprintln(w, "Program continues after recovery")
+}
+
+// Go runs fn in a new goroutine, recovering any panic and passing the
+// recovered value to onPanic. A recover() in the caller can't catch a
+// panic from another goroutine, so without this the whole program dies.
+func Go(fn func(), onPanic func(any)) {
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				onPanic(r)
+			}
+		}()
+		fn()
+	}()
+}
+
+func safeGoroutineExample(w io.Writer) {
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	// Each worker calls Done exactly once: either on success or in onPanic
+	Go(func() {
+		fmt.Fprintln(w, "Worker 1 finished normally")
+		wg.Done()
+	}, func(r any) {
+		fmt.Fprintf(w, "Worker 1 panicked: %v\n", r)
+		wg.Done()
+	})
+
+	Go(func() {
+		var m map[string]int
+		m["boom"] = 1 // assignment to nil map panics
+		wg.Done()     // never reached
+	}, func(r any) {
+		fmt.Fprintf(w, "Worker 2 panicked: %v\n", r)
+		wg.Done()
+	})
+
+	wg.Wait()
+	fmt.Fprintln(w, "Program continues after goroutine panic")
+}
+
+// safeCall runs f and converts a panic into an error. The named result
+// is what makes this work: the deferred func runs after the panic and can
+// still assign err before safeCall returns to its caller.
+func safeCall(f func()) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("recovered: %v", r)
+		}
+	}()
+	f()
+	return nil
+}
+
+func safeCallExample(w io.Writer) {
+	err := safeCall(func() {
+		fmt.Fprintln(w, "Doing some safe work")
+	})
+	fmt.Fprintf(w, "safeCall(no panic) error: %v\n", err)
+
+	err = safeCall(func() {
+		var items []int
+		_ = items[3] // index out of range panics
+	})
+	fmt.Fprintf(w, "safeCall(panic) error: %v\n", err)
+
+	if err != nil {
+		fmt.Fprintln(w, "The caller handles it like any other error - no crash")
+	}
+}
+
+// trace prints when name starts and returns a func that prints how long
+// it took. Note the trailing (): defer trace("work", w)() calls trace NOW,
+// recording the start time, and defers only the returned closure.
+func trace(name string, w io.Writer) func() {
+	start := time.Now()
+	fmt.Fprintf(w, "enter %s\n", name)
+	return func() {
+		fmt.Fprintf(w, "exit %s (took %v)\n", name, time.Since(start).Round(time.Millisecond))
+	}
+}
+
+func traceExample(w io.Writer) {
+	defer trace("traceExample", w)()
+
+	loadData := func() {
+		defer trace("loadData", w)()
+		time.Sleep(50 * time.Millisecond) // simulated workload
+	}
+	loadData()
+
+	time.Sleep(20 * time.Millisecond)
+	fmt.Fprintln(w, "traceExample doing its own work")
+}
+
+// parsePanic is the one panic value parseQuantities expects: its helpers
+// panic with it to bail out of deep recursion on bad input
+type parsePanic struct {
+	input string
+}
+
+func (p parsePanic) Error() string {
+	return fmt.Sprintf("invalid quantity %q", p.input)
+}
+
+// recoverOnly recovers a panic whose value is a T and passes it to handle;
+// any other panic is re-raised unchanged, so genuine bugs (nil maps, bad
+// indexes) still crash loudly instead of being mistaken for bad input.
+// It must be deferred directly - recover only works in the deferred call:
+//
+//	defer recoverOnly(func(p parsePanic) { err = p })
+func recoverOnly[T any](handle func(T)) {
+	r := recover()
+	if r == nil {
+		return
+	}
+	p, ok := r.(T)
+	if !ok {
+		panic(r) // not ours: keep unwinding
+	}
+	handle(p)
+}
+
+// parseQuantities parses every s as a positive int. A parsePanic from
+// anywhere below becomes err; any other panic escapes.
+func parseQuantities(inputs []string) (total int, err error) {
+	defer recoverOnly(func(p parsePanic) { err = p })
+	for _, s := range inputs {
+		total += mustParseQuantity(s)
+	}
+	return total, nil
+}
+
+func mustParseQuantity(s string) int {
+	if s == "boom" {
+		var limits map[string]int
+		limits[s] = 1 // a genuine bug: assignment to a nil map
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n <= 0 {
+		panic(parsePanic{input: s})
+	}
+	return n
+}
+
+func recoverOnlyExample(w io.Writer) {
+	total, err := parseQuantities([]string{"3", "4"})
+	fmt.Fprintf(w, "parseQuantities([3 4]): total=%d, err=%v\n", total, err)
+
+	_, err = parseQuantities([]string{"3", "-2"})
+	fmt.Fprintf(w, "parseQuantities([3 -2]): err=%v (expected panic, recovered)\n", err)
+
+	// The nil-map panic isn't a parsePanic, so recoverOnly re-panics.
+	// safeCall (example 7) catches it here only to keep the demo running.
+	err = safeCall(func() { parseQuantities([]string{"3", "boom"}) })
+	fmt.Fprintf(w, "parseQuantities([3 boom]): %v (unexpected panic, re-raised)\n", err)
+}
@@ -0,0 +1,100 @@
+package functions
+
+import (
+	"errors"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRecoverOnlyHandlesExpectedPanic(t *testing.T) {
+	_, err := parseQuantities([]string{"1", "zero"})
+	var p parsePanic
+	if !errors.As(err, &p) || p.input != "zero" {
+		t.Errorf("err = %v, want a parsePanic for %q", err, "zero")
+	}
+}
+
+func TestRecoverOnlyRepanicsUnexpected(t *testing.T) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Fatal("unexpected panic was swallowed; want it to propagate")
+		}
+		// The original runtime error comes through, not a wrapped copy
+		if _, ok := r.(runtime.Error); !ok {
+			t.Errorf("recovered %T (%v), want the original runtime.Error", r, r)
+		}
+	}()
+	parseQuantities([]string{"1", "boom"})
+	t.Fatal("parseQuantities returned normally; want a panic")
+}
+
+// Run with -race: the recovered value travels from the worker goroutine
+// to the test over a channel, so there is no unsynchronized sharing.
+//
+//	go test -race -run TestGoRecoversPanic ./functions
+func TestGoRecoversPanic(t *testing.T) {
+	recovered := make(chan any, 1)
+	Go(func() { panic("worker failed") }, func(r any) { recovered <- r })
+
+	select {
+	case r := <-recovered:
+		if r != "worker failed" {
+			t.Errorf("onPanic got %v, want %q", r, "worker failed")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("onPanic was not called within 1s")
+	}
+
+	// The process survived; goroutines still run normally afterwards
+	done := make(chan struct{})
+	Go(func() { close(done) }, func(r any) { t.Errorf("unexpected panic: %v", r) })
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("second goroutine did not run")
+	}
+}
+
+func TestGoRuntimeErrorPanic(t *testing.T) {
+	recovered := make(chan any, 1)
+	Go(func() {
+		var m map[string]int
+		m["boom"] = 1
+	}, func(r any) { recovered <- r })
+
+	if _, ok := (<-recovered).(runtime.Error); !ok {
+		t.Error("want the runtime.Error from the nil map write")
+	}
+}
+
+func TestTraceReportsDuration(t *testing.T) {
+	var sb strings.Builder
+	done := trace("work", &sb)
+	if got := sb.String(); got != "enter work\n" {
+		t.Errorf("trace output before the closure = %q, want %q", got, "enter work\n")
+	}
+
+	const work = 2 * time.Millisecond
+	time.Sleep(work)
+	done()
+
+	// Second line: "exit work (took 2ms)"
+	lines := strings.Split(strings.TrimSuffix(sb.String(), "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("trace wrote %q, want an enter and an exit line", sb.String())
+	}
+	took, ok := strings.CutPrefix(lines[1], "exit work (took ")
+	if !ok || !strings.HasSuffix(took, ")") {
+		t.Fatalf("exit line = %q, want \"exit work (took <duration>)\"", lines[1])
+	}
+	d, err := time.ParseDuration(strings.TrimSuffix(took, ")"))
+	if err != nil {
+		t.Fatalf("exit line %q: %v", lines[1], err)
+	}
+	if d < work { // also rules out a negative duration
+		t.Errorf("reported duration = %v, want at least the %v slept", d, work)
+	}
+}
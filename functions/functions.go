@@ -0,0 +1,35 @@
+// Package functions collects the tutorial's examples about functions:
+// multiple and named results, defer/panic/recover, and functions running
+// as goroutines. Run them all with "go run ./cmd/tutorial functions".
+package functions
+
+import (
+	"fmt"
+	"io"
+)
+
+// examples lists every example in the order Run prints them
+var examples = []struct {
+	title string
+	run   func(io.Writer)
+}{
+	{"Multiple Return Values", RunMultipleReturn},
+	{"Named Result Parameters", RunNamedResults},
+	{"Defer, Panic, and Recover", RunDefer},
+	{"Worker Pool", RunWorkerPoolDemo},
+	{"Context Cancellation", RunContextDemo},
+	{"Channel Select", ChannelSelect},
+	{"Rate Limiting", RunRateLimiterDemo},
+	{"Goroutine Leaks", RunLeakDemo},
+}
+
+// Run runs every example in turn, each under its own heading
+func Run(w io.Writer) {
+	for i, ex := range examples {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintf(w, "=== %s ===\n", ex.title)
+		ex.run(w)
+	}
+}
@@ -0,0 +1,95 @@
+package functions
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"runtime"
+	"time"
+)
+
+// RunLeakDemo asks several goroutines for the same answer and keeps only
+// the first reply. Done naively, the others block forever on a send that
+// nobody receives: a goroutine leak. runtime.NumGoroutine shows it.
+func RunLeakDemo(w io.Writer) {
+	const replicas = 5
+
+	fmt.Fprintln(w, "1. Unbuffered channel - the slower replies leak:")
+	before := runtime.NumGoroutine()
+	fmt.Fprintf(w, "  first reply: %d\n", firstReplyLeaky(replicas))
+	after := waitForGoroutines(before, 100*time.Millisecond)
+	fmt.Fprintf(w, "  goroutines: %d before, %d after → %d leaked (blocked until the program exits)\n",
+		before, after, after-before)
+
+	fmt.Fprintln(w, "\n2. Buffered channel - every send has room, so every goroutine exits:")
+	before = runtime.NumGoroutine()
+	fmt.Fprintf(w, "  first reply: %d\n", firstReplyBuffered(replicas))
+	after = waitForGoroutines(before, 100*time.Millisecond)
+	fmt.Fprintf(w, "  goroutines: %d before, %d after\n", before, after)
+
+	fmt.Fprintln(w, "\n3. Context - the losers give up when the caller cancels:")
+	before = runtime.NumGoroutine()
+	fmt.Fprintf(w, "  first reply: %d\n", firstReplyContext(context.Background(), replicas))
+	after = waitForGoroutines(before, 100*time.Millisecond)
+	fmt.Fprintf(w, "  goroutines: %d before, %d after\n", before, after)
+}
+
+// replica simulates a server that answers after a delay; replica 0 is
+// the fastest
+func replica(i int) int {
+	time.Sleep(time.Duration(i+1) * 5 * time.Millisecond)
+	return i
+}
+
+// firstReplyLeaky is the bug: the channel is unbuffered and only one
+// value is ever received, so n-1 goroutines block on send forever
+func firstReplyLeaky(n int) int {
+	ch := make(chan int)
+	for i := 0; i < n; i++ {
+		go func() { ch <- replica(i) }()
+	}
+	return <-ch
+}
+
+// firstReplyBuffered gives the channel room for every reply, so no send
+// ever blocks. The unread values are garbage collected with the channel.
+func firstReplyBuffered(n int) int {
+	ch := make(chan int, n)
+	for i := 0; i < n; i++ {
+		go func() { ch <- replica(i) }()
+	}
+	return <-ch
+}
+
+// firstReplyContext keeps the channel unbuffered but lets each sender
+// bail out once the caller has its answer and cancels ctx
+func firstReplyContext(ctx context.Context, n int) int {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel() // runs after the first receive: releases the others
+
+	ch := make(chan int)
+	for i := 0; i < n; i++ {
+		go func() {
+			select {
+			case ch <- replica(i):
+			case <-ctx.Done():
+			}
+		}()
+	}
+	return <-ch
+}
+
+// waitForGoroutines polls until at most want goroutines are running or
+// timeout passes, and returns the last count. Goroutines that are about
+// to exit need a moment to do so, so a single NumGoroutine call right
+// after the work would overcount.
+func waitForGoroutines(want int, timeout time.Duration) int {
+	deadline := time.Now().Add(timeout)
+	for {
+		n := runtime.NumGoroutine()
+		if n <= want || time.Now().After(deadline) {
+			return n
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
@@ -0,0 +1,29 @@
+package functions
+
+import (
+	"context"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestFirstReplyNoLeak(t *testing.T) {
+	fixes := []struct {
+		name  string
+		first func(n int) int
+	}{
+		{"buffered", firstReplyBuffered},
+		{"context", func(n int) int { return firstReplyContext(context.Background(), n) }},
+	}
+	for _, fix := range fixes {
+		t.Run(fix.name, func(t *testing.T) {
+			baseline := runtime.NumGoroutine()
+			if got := fix.first(5); got != 0 {
+				t.Errorf("first reply = %d, want 0 (the fastest replica)", got)
+			}
+			if n := waitForGoroutines(baseline, time.Second); n > baseline {
+				t.Errorf("%d goroutines still running after 1s, baseline %d", n, baseline)
+			}
+		})
+	}
+}
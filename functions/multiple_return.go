@@ -1,26 +1,28 @@
-package main
+package functions
 
 import (
 	"fmt"
+	"io"
 	"math"
 )
 
-func main() {
+// RunMultipleReturn demonstrates functions that return several values
+func RunMultipleReturn(w io.Writer) {
 	// Multiple return values - get both sum and difference
 	sum, diff := addAndSubtract(10, 5)
-	fmt.Printf("Sum: %d, Difference: %d\n", sum, diff)
+	fmt.Fprintf(w, "Sum: %d, Difference: %d\n", sum, diff)
 
 	// Multiple return values - calculate area and perimeter
 	area, perimeter := rectangle(4, 6)
-	fmt.Printf("Rectangle - Area: %.2f, Perimeter: %.2f\n", area, perimeter)
+	fmt.Fprintf(w, "Rectangle - Area: %.2f, Perimeter: %.2f\n", area, perimeter)
 
 	// Multiple return values - check if number is prime and get its factors
 	isPrime, factors := analyzeNumber(17)
-	fmt.Printf("Number 17 - Prime: %t, Factors: %v\n", isPrime, factors)
+	fmt.Fprintf(w, "Number 17 - Prime: %t, Factors: %v\n", isPrime, factors)
 
 	// Using underscore to ignore one return value
 	_, perimeter2 := rectangle(3, 8)
-	fmt.Printf("Rectangle perimeter only: %.2f\n", perimeter2)
+	fmt.Fprintf(w, "Rectangle perimeter only: %.2f\n", perimeter2)
 }
 
 // addAndSubtract returns both sum and difference of two numbers
@@ -65,4 +67,4 @@ func analyzeNumber(n int) (bool, []int) {
 	}
 
 	return isPrime, factors
-}
\ No newline at end of file
+}
@@ -1,27 +1,29 @@
-package main
+package functions
 
 import (
 	"fmt"
+	"io"
 	"strings"
 )
 
-func main() {
+// RunNamedResults demonstrates named result parameters and naked returns
+func RunNamedResults(w io.Writer) {
 	// Named result parameters - simple division
 	quotient, remainder := divide(17, 5)
-	fmt.Printf("17 ÷ 5 = %d remainder %d\n", quotient, remainder)
+	fmt.Fprintf(w, "17 ÷ 5 = %d remainder %d\n", quotient, remainder)
 
 	// Named result parameters - person info
 	name, isAdult := getPersonInfo("Alice", 25)
-	fmt.Printf("Name: %s, Age: 25, Adult: %t\n", name, isAdult)
+	fmt.Fprintf(w, "Name: %s, Age: 25, Adult: %t\n", name, isAdult)
 
 	// Named result parameters - string analysis
 	upper, lower, digitCount := analyzeString("Hello123")
-	fmt.Printf("String 'Hello123': Uppercase=%d, Lowercase=%d, Digits=%d\n", upper, lower, digitCount)
+	fmt.Fprintf(w, "String 'Hello123': Uppercase=%d, Lowercase=%d, Digits=%d\n", upper, lower, digitCount)
 
 	// Named result parameters with default values
-	fmt.Println("Testing splitString:")
+	fmt.Fprintln(w, "Testing splitString:")
 	words, count := splitString("Go is awesome")
-	fmt.Printf("Words: %v, Count: %d\n", words, count)
+	fmt.Fprintf(w, "Words: %v, Count: %d\n", words, count)
 }
 
 // Named result parameters - quotient and remainder
@@ -43,7 +45,7 @@ func getPersonInfo(name string, age int) (formattedName string, isAdult bool) {
 	// Named parameters get zero values initially
 	formattedName = strings.Title(strings.ToLower(name))
 	isAdult = age >= 18
-	
+
 	// Return without explicit values
 	return
 }
@@ -74,4 +76,4 @@ func splitString(s string) (words []string, count int) {
 	words = strings.Fields(s)
 	count = len(words)
 	return
-}
\ No newline at end of file
+}
@@ -0,0 +1,44 @@
+package functions
+
+import (
+	"testing"
+	"unicode/utf8"
+)
+
+// FuzzAnalyzeString checks analyzeString against random input:
+//
+//	go test -run '^$' -fuzz FuzzAnalyzeString -fuzztime 30s ./functions
+//
+// Without -fuzz, go test only runs the seed corpus below.
+func FuzzAnalyzeString(f *testing.F) {
+	for _, seed := range []string{
+		"",
+		"Hello123",
+		"ALL CAPS",
+		"héllo wörld", // multibyte letters are not ASCII letters
+		"日本語 2024",
+		"🙂🙂🙂",
+		"\xff\xfe", // invalid UTF-8
+	} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		upper, lower, digits := analyzeString(s)
+		if upper < 0 || lower < 0 || digits < 0 {
+			t.Fatalf("analyzeString(%q) = %d, %d, %d: negative count", s, upper, lower, digits)
+		}
+
+		// Count runes, not bytes: "é" is 2 bytes but 1 character. Invalid
+		// bytes each become one utf8.RuneError, both when ranging over s
+		// (as analyzeString does) and in []rune(s).
+		runes := len([]rune(s))
+		if runes != utf8.RuneCountInString(s) {
+			t.Fatalf("len([]rune(%q)) = %d, RuneCountInString = %d", s, runes, utf8.RuneCountInString(s))
+		}
+		if total := upper + lower + digits; total > runes {
+			t.Errorf("analyzeString(%q) counted %d+%d+%d = %d characters, but s has only %d runes",
+				s, upper, lower, digits, total, runes)
+		}
+	})
+}
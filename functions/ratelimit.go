@@ -0,0 +1,94 @@
+package functions
+
+import (
+	"fmt"
+	"io"
+	"time"
+)
+
+// RunRateLimiterDemo sends requests through a limiter, first one at a
+// time and then with a burst allowance
+func RunRateLimiterDemo(w io.Writer) {
+	fmt.Fprintln(w, "1. 5 requests at 20/sec - one every 50ms:")
+	offsets := RunRateLimiter(5, 20, w)
+	fmt.Fprintf(w, "  total: ~%v\n", offsets[len(offsets)-1].Round(10*time.Millisecond))
+
+	fmt.Fprintln(w, "\n2. Same rate, burst of 3 - the saved-up tokens go first:")
+	lim := newRateLimiter(20, 3)
+	defer lim.Stop()
+	runLimited(5, lim, w)
+}
+
+// RunRateLimiter processes requests at no more than perSecond, logging
+// when each one runs, and returns each request's start offset
+func RunRateLimiter(requests, perSecond int, w io.Writer) []time.Duration {
+	lim := newRateLimiter(perSecond, 1)
+	defer lim.Stop()
+	return runLimited(requests, lim, w)
+}
+
+func runLimited(requests int, lim *rateLimiter, w io.Writer) []time.Duration {
+	start := time.Now()
+	offsets := make([]time.Duration, 0, requests)
+	for i := 1; i <= requests; i++ {
+		lim.Wait()
+		at := time.Since(start)
+		offsets = append(offsets, at)
+		fmt.Fprintf(w, "  request %d at +%v\n", i, at.Round(time.Millisecond))
+	}
+	return offsets
+}
+
+// rateLimiter is a token bucket: the buffered channel is the bucket,
+// Wait takes a token, and a ticker puts one back every 1/perSecond.
+//   - capacity (burst) caps how many tokens can pile up while idle
+//   - when the bucket is full, the refill is dropped rather than blocking
+type rateLimiter struct {
+	tokens chan struct{}
+	ticker *time.Ticker
+	done   chan struct{}
+}
+
+// newRateLimiter returns a limiter that starts with a full bucket
+func newRateLimiter(perSecond, burst int) *rateLimiter {
+	if perSecond < 1 {
+		perSecond = 1
+	}
+	if burst < 1 {
+		burst = 1 // a zero-capacity bucket could never hold a token
+	}
+	lim := &rateLimiter{
+		tokens: make(chan struct{}, burst),
+		ticker: time.NewTicker(time.Second / time.Duration(perSecond)),
+		done:   make(chan struct{}),
+	}
+	for i := 0; i < burst; i++ {
+		lim.tokens <- struct{}{}
+	}
+
+	go func() {
+		for {
+			select {
+			case <-lim.ticker.C:
+				select {
+				case lim.tokens <- struct{}{}:
+				default: // bucket full
+				}
+			case <-lim.done:
+				return
+			}
+		}
+	}()
+	return lim
+}
+
+// Wait blocks until a token is available and takes it
+func (l *rateLimiter) Wait() {
+	<-l.tokens
+}
+
+// Stop releases the ticker and the refill goroutine
+func (l *rateLimiter) Stop() {
+	l.ticker.Stop()
+	close(l.done)
+}
@@ -0,0 +1,36 @@
+package functions
+
+import (
+	"io"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterMinimumDuration(t *testing.T) {
+	const requests, perSecond = 6, 50
+	// The first token is already in the bucket; each of the other
+	// requests waits one interval for a refill
+	want := (requests - 1) * (time.Second / perSecond)
+
+	start := time.Now()
+	offsets := RunRateLimiter(requests, perSecond, io.Discard)
+	elapsed := time.Since(start)
+
+	if len(offsets) != requests {
+		t.Fatalf("got %d offsets, want %d", len(offsets), requests)
+	}
+	// Tickers never fire early, and start was taken before the
+	// limiter's ticker was created, so no slack is needed
+	if elapsed < want {
+		t.Errorf("%d requests at %d/sec took %v, want at least %v", requests, perSecond, elapsed, want)
+	}
+}
+
+func TestRateLimiterBurst(t *testing.T) {
+	lim := newRateLimiter(10, 3)
+	defer lim.Stop()
+	offsets := runLimited(3, lim, io.Discard)
+	if last := offsets[len(offsets)-1]; last > 50*time.Millisecond {
+		t.Errorf("3 requests with burst 3 took %v, want them immediately", last)
+	}
+}
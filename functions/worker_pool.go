@@ -0,0 +1,79 @@
+package functions
+
+import (
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// RunWorkerPoolDemo squares eight jobs with three workers
+func RunWorkerPoolDemo(w io.Writer) {
+	jobs := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	results := RunWorkerPool(3, jobs, w)
+
+	fmt.Fprintf(w, "\nJobs:    %v\n", jobs)
+	fmt.Fprintf(w, "Results: %v (squares, in job order)\n", results)
+}
+
+// job is one unit of work; index says where its result belongs
+type job struct {
+	index int
+	value int
+}
+
+type result struct {
+	index  int
+	value  int
+	worker int
+}
+
+// RunWorkerPool squares every job using numWorkers goroutines and
+// returns the results in the same order as jobs. Progress is logged to w.
+//
+// The shape is the classic pool:
+//   - a jobs channel that the sender closes when there is no more work
+//   - workers that range over jobs, so they exit once it's closed and drained
+//   - a WaitGroup that closes results after the last worker exits
+func RunWorkerPool(numWorkers int, jobs []int, w io.Writer) []int {
+	if numWorkers < 1 {
+		numWorkers = 1 // with no workers, nobody would ever receive a job
+	}
+	jobCh := make(chan job)
+	resultCh := make(chan result)
+
+	var wg sync.WaitGroup
+	for id := 1; id <= numWorkers; id++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobCh {
+				time.Sleep(10 * time.Millisecond) // simulated work
+				resultCh <- result{index: j.index, value: j.value * j.value, worker: id}
+			}
+		}()
+	}
+
+	// Send jobs from their own goroutine: sending and collecting at the
+	// same time keeps neither side blocked on the other
+	go func() {
+		for i, v := range jobs {
+			jobCh <- job{index: i, value: v}
+		}
+		close(jobCh) // no more work: lets the workers' range loops end
+	}()
+
+	// Close results only when every worker is done sending
+	go func() {
+		wg.Wait()
+		close(resultCh)
+	}()
+
+	// Only this goroutine touches results and w, so no locking is needed
+	results := make([]int, len(jobs))
+	for r := range resultCh {
+		fmt.Fprintf(w, "worker %d: job %d → %d\n", r.worker, jobs[r.index], r.value)
+		results[r.index] = r.value
+	}
+	return results
+}
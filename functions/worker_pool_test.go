@@ -0,0 +1,60 @@
+package functions
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestRunWorkerPoolProcessesEachJobOnce(t *testing.T) {
+	jobs := make([]int, 40)
+	for i := range jobs {
+		jobs[i] = i + 1
+	}
+
+	for _, workers := range []int{1, 4, 50} { // 50: more workers than jobs
+		var log strings.Builder
+		results := RunWorkerPool(workers, jobs, &log)
+
+		want := make([]int, len(jobs))
+		for i, v := range jobs {
+			want[i] = v * v
+		}
+		if !slices.Equal(results, want) {
+			t.Errorf("%d workers: results = %v, want %v (squares in job order)", workers, results, want)
+		}
+
+		// One log line per job: a job run twice or not at all shows up here
+		seen := make(map[int]int)
+		for _, line := range strings.Split(strings.TrimSpace(log.String()), "\n") {
+			var worker, job, square int
+			if _, err := fmt.Sscanf(line, "worker %d: job %d → %d", &worker, &job, &square); err != nil {
+				t.Fatalf("%d workers: log line %q: %v", workers, line, err)
+			}
+			if worker < 1 || worker > workers {
+				t.Errorf("%d workers: job %d ran on worker %d", workers, job, worker)
+			}
+			seen[job]++
+		}
+		for _, v := range jobs {
+			if seen[v] != 1 {
+				t.Errorf("%d workers: job %d processed %d times, want 1", workers, v, seen[v])
+			}
+		}
+		if len(seen) != len(jobs) {
+			t.Errorf("%d workers: %d distinct jobs logged, want %d", workers, len(seen), len(jobs))
+		}
+	}
+}
+
+func TestRunWorkerPoolEdgeCases(t *testing.T) {
+	if got := RunWorkerPool(3, nil, io.Discard); len(got) != 0 {
+		t.Errorf("RunWorkerPool(3, nil) = %v, want empty", got)
+	}
+	// No workers is bumped to one rather than deadlocking
+	if got, want := RunWorkerPool(0, []int{2, 3}, io.Discard), []int{4, 9}; !slices.Equal(got, want) {
+		t.Errorf("RunWorkerPool(0, [2 3]) = %v, want %v", got, want)
+	}
+}